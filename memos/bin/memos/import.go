@@ -0,0 +1,106 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
+)
+
+var (
+	importUsername string
+	importDryRun   bool
+
+	importCmd = &cobra.Command{
+		Use:   "import <zip file or folder>",
+		Short: "Import memos from a zip archive or a folder of Markdown files",
+		Args:  cobra.ExactArgs(1),
+		Run: func(_ *cobra.Command, args []string) {
+			// Exit with a non-zero code, so that scripts can tell the import failed.
+			if err := runImport(context.Background(), args[0]); err != nil {
+				slog.Error("failed to import memos", slog.Any("err", err))
+				os.Exit(1)
+			}
+		},
+	}
+)
+
+func init() {
+	importCmd.Flags().StringVarP(&importUsername, "user", "u", "", "username of the owner of the imported memos")
+	importCmd.Flags().BoolVarP(&importDryRun, "dry-run", "", false, "report what would be imported without importing anything")
+	if err := importCmd.MarkFlagRequired("user"); err != nil {
+		panic(err)
+	}
+	rootCmd.AddCommand(importCmd)
+}
+
+func runImport(ctx context.Context, source string) error {
+	dbDriver, err := db.NewDBDriver(instanceProfile)
+	if err != nil {
+		return errors.Wrap(err, "failed to create db driver")
+	}
+	defer dbDriver.Close()
+	if err := dbDriver.Migrate(ctx); err != nil {
+		return errors.Wrap(err, "failed to migrate database")
+	}
+	storeInstance := store.New(dbDriver, instanceProfile)
+
+	user, err := storeInstance.GetUser(ctx, &store.FindUser{Username: &importUsername})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil {
+		return errors.Errorf("user %s not found", importUsername)
+	}
+
+	fsys, closeFS, err := openImportFS(source)
+	if err != nil {
+		return errors.Wrap(err, "failed to open import source")
+	}
+	defer closeFS()
+
+	response, err := apiv1.ImportMemosFromFS(ctx, storeInstance, user, fsys, importDryRun)
+	if err != nil {
+		return err
+	}
+	printImportReport(response)
+	return nil
+}
+
+// openImportFS opens a zip archive or a folder as a file system.
+func openImportFS(path string) (fs.FS, func(), error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if info.IsDir() {
+		return os.DirFS(path), func() {}, nil
+	}
+	reader, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return reader, func() { reader.Close() }, nil
+}
+
+func printImportReport(response *v1pb.ImportMemosResponse) {
+	for _, result := range response.Results {
+		switch result.Action {
+		case v1pb.ImportMemoResult_IMPORT:
+			fmt.Printf("import %s (uid: %s, resources: %d) %s\n", result.Path, result.Uid, len(result.Resources), result.Memo)
+		case v1pb.ImportMemoResult_SKIP:
+			fmt.Printf("skip   %s: %s\n", result.Path, result.Message)
+		}
+	}
+	fmt.Printf("---\n%d imported, %d skipped\n", response.ImportedCount, response.SkippedCount)
+}
@@ -62,6 +62,57 @@ func (s *Schedule) IsDue(m *Moment) bool {
 	return true
 }
 
+// nextSearchYears bounds the search of Schedule.Next(),
+// since some schedules never match (eg. "0 0 30 2 *").
+const nextSearchYears = 5
+
+// Next returns the first minute after t that satisfies the current Schedule,
+// evaluated in the location of t.
+//
+// It returns the zero time if there is no such minute in the next 5 years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	next := t.Truncate(time.Minute).Add(time.Minute)
+	limit := next.AddDate(nextSearchYears, 0, 0)
+
+	// advance moves to the candidate, which is never before the next minute
+	// (a wall clock time may repeat on daylight saving time changes).
+	advance := func(candidate time.Time) {
+		if !candidate.After(next) {
+			candidate = next.Add(time.Minute)
+		}
+		next = candidate
+	}
+
+	for next.Before(limit) {
+		if _, ok := s.Months[int(next.Month())]; !ok {
+			advance(time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, loc))
+			continue
+		}
+
+		_, dayOk := s.Days[next.Day()]
+		_, dayOfWeekOk := s.DaysOfWeek[int(next.Weekday())]
+		if !dayOk || !dayOfWeekOk {
+			advance(time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, loc))
+			continue
+		}
+
+		if _, ok := s.Hours[next.Hour()]; !ok {
+			advance(time.Date(next.Year(), next.Month(), next.Day(), next.Hour()+1, 0, 0, 0, loc))
+			continue
+		}
+
+		if _, ok := s.Minutes[next.Minute()]; !ok {
+			advance(next.Add(time.Minute))
+			continue
+		}
+
+		return next
+	}
+
+	return time.Time{}
+}
+
 // NewSchedule creates a new Schedule from a cron expression.
 //
 // A cron expression is consisted of 5 segments separated by space,
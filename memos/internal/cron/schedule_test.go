@@ -359,3 +359,56 @@ func TestScheduleIsDue(t *testing.T) {
 		}
 	}
 }
+
+func TestScheduleNext(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scenarios := []struct {
+		cronExpr string
+		from     time.Time
+		expected time.Time
+	}{
+		{
+			"* * * * *",
+			time.Date(2024, 6, 1, 10, 30, 15, 0, time.UTC),
+			time.Date(2024, 6, 1, 10, 31, 0, 0, time.UTC),
+		},
+		{
+			"0 9 * * 1",
+			time.Date(2024, 6, 1, 10, 30, 0, 0, time.UTC),
+			time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			"30 18 1 * *",
+			time.Date(2024, 12, 1, 18, 30, 0, 0, time.UTC),
+			time.Date(2025, 1, 1, 18, 30, 0, 0, time.UTC),
+		},
+		{
+			// 02:30 does not exist on the day the clocks go forward.
+			"30 2 * * *",
+			time.Date(2024, 3, 30, 12, 0, 0, 0, berlin),
+			time.Date(2024, 4, 1, 2, 30, 0, 0, berlin),
+		},
+		{
+			"0 0 30 2 *",
+			time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			time.Time{},
+		},
+	}
+
+	for i, s := range scenarios {
+		schedule, err := cron.NewSchedule(s.cronExpr)
+		if err != nil {
+			t.Fatalf("[%d-%s] Unexpected cron error: %v", i, s.cronExpr, err)
+		}
+
+		result := schedule.Next(s.from)
+
+		if !result.Equal(s.expected) {
+			t.Fatalf("[%d-%s] Expected %v, got %v", i, s.cronExpr, s.expected, result)
+		}
+	}
+}
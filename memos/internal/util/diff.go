@@ -0,0 +1,172 @@
+package util
+
+import "strings"
+
+// DiffOperation is the operation of a line in a diff.
+type DiffOperation int
+
+const (
+	// DiffEqual means the line is present in both texts.
+	DiffEqual DiffOperation = iota
+	// DiffInsert means the line is only present in the new text.
+	DiffInsert
+	// DiffDelete means the line is only present in the old text.
+	DiffDelete
+)
+
+// DiffLine is a single line of a line-based diff.
+type DiffLine struct {
+	Operation DiffOperation
+	Text      string
+}
+
+// DiffLines returns the shortest line-based diff from the old text to the new text.
+// It uses the bisection of Myers' O(ND) algorithm, so the memory is linear in the number of lines.
+func DiffLines(oldText, newText string) []DiffLine {
+	return diffLines(splitLines(oldText), splitLines(newText))
+}
+
+func diffLines(oldLines, newLines []string) []DiffLine {
+	// Strip the common prefix and suffix, which are equal lines.
+	prefix := 0
+	for prefix < len(oldLines) && prefix < len(newLines) && oldLines[prefix] == newLines[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(oldLines)-prefix && suffix < len(newLines)-prefix && oldLines[len(oldLines)-suffix-1] == newLines[len(newLines)-suffix-1] {
+		suffix++
+	}
+
+	diff := []DiffLine{}
+	diff = appendDiffLines(diff, DiffEqual, oldLines[:prefix])
+	diff = append(diff, diffMiddleLines(oldLines[prefix:len(oldLines)-suffix], newLines[prefix:len(newLines)-suffix])...)
+	diff = appendDiffLines(diff, DiffEqual, oldLines[len(oldLines)-suffix:])
+	return diff
+}
+
+// diffMiddleLines returns the diff of the lines whose first and last lines differ.
+func diffMiddleLines(oldLines, newLines []string) []DiffLine {
+	diff := []DiffLine{}
+	if len(oldLines) == 0 {
+		return appendDiffLines(diff, DiffInsert, newLines)
+	}
+	if len(newLines) == 0 {
+		return appendDiffLines(diff, DiffDelete, oldLines)
+	}
+	// A single line is either kept among the inserted ones or replaced.
+	if len(oldLines) == 1 {
+		for i, line := range newLines {
+			if line == oldLines[0] {
+				diff = appendDiffLines(diff, DiffInsert, newLines[:i])
+				diff = appendDiffLines(diff, DiffEqual, oldLines)
+				return appendDiffLines(diff, DiffInsert, newLines[i+1:])
+			}
+		}
+		diff = appendDiffLines(diff, DiffDelete, oldLines)
+		return appendDiffLines(diff, DiffInsert, newLines)
+	}
+	if len(newLines) == 1 {
+		for i, line := range oldLines {
+			if line == newLines[0] {
+				diff = appendDiffLines(diff, DiffDelete, oldLines[:i])
+				diff = appendDiffLines(diff, DiffEqual, newLines)
+				return appendDiffLines(diff, DiffDelete, oldLines[i+1:])
+			}
+		}
+		diff = appendDiffLines(diff, DiffDelete, oldLines)
+		return appendDiffLines(diff, DiffInsert, newLines)
+	}
+
+	x, y := bisectLines(oldLines, newLines)
+	diff = append(diff, diffLines(oldLines[:x], newLines[:y])...)
+	return append(diff, diffLines(oldLines[x:], newLines[y:])...)
+}
+
+// bisectLines returns the point where the forward and the reverse shortest edit paths of the lines meet,
+// which splits the diff into two smaller ones.
+func bisectLines(oldLines, newLines []string) (int, int) {
+	oldLength, newLength := len(oldLines), len(newLines)
+	maxD := (oldLength + newLength + 1) / 2
+	offset := maxD
+	// forward[offset+k] and reverse[offset+k] are the furthest x reached on the diagonal k from the start and the end.
+	forward, reverse := make([]int, 2*maxD), make([]int, 2*maxD)
+	for i := range forward {
+		forward[i], reverse[i] = -1, -1
+	}
+	forward[offset+1], reverse[offset+1] = 0, 0
+
+	delta := oldLength - newLength
+	// The forward path collides with the reverse path if the total number of lines is odd.
+	front := delta%2 != 0
+	// The diagonals beyond the edit graph are skipped.
+	forwardStart, forwardEnd, reverseStart, reverseEnd := 0, 0, 0, 0
+	for d := 0; d < maxD; d++ {
+		for k := -d + forwardStart; k <= d-forwardEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && forward[offset+k-1] < forward[offset+k+1]) {
+				x = forward[offset+k+1]
+			} else {
+				x = forward[offset+k-1] + 1
+			}
+			y := x - k
+			for x < oldLength && y < newLength && oldLines[x] == newLines[y] {
+				x++
+				y++
+			}
+			forward[offset+k] = x
+			if x > oldLength {
+				forwardEnd += 2
+			} else if y > newLength {
+				forwardStart += 2
+			} else if front {
+				reverseK := offset + delta - k
+				if reverseK >= 0 && reverseK < len(reverse) && reverse[reverseK] != -1 && x >= oldLength-reverse[reverseK] {
+					return x, y
+				}
+			}
+		}
+		for k := -d + reverseStart; k <= d-reverseEnd; k += 2 {
+			var x int
+			if k == -d || (k != d && reverse[offset+k-1] < reverse[offset+k+1]) {
+				x = reverse[offset+k+1]
+			} else {
+				x = reverse[offset+k-1] + 1
+			}
+			y := x - k
+			for x < oldLength && y < newLength && oldLines[oldLength-x-1] == newLines[newLength-y-1] {
+				x++
+				y++
+			}
+			reverse[offset+k] = x
+			if x > oldLength {
+				reverseEnd += 2
+			} else if y > newLength {
+				reverseStart += 2
+			} else if !front {
+				forwardK := offset + delta - k
+				if forwardK >= 0 && forwardK < len(forward) && forward[forwardK] != -1 {
+					forwardX := forward[forwardK]
+					if forwardX >= oldLength-x {
+						return forwardX, offset + forwardX - forwardK
+					}
+				}
+			}
+		}
+	}
+	// The paths always meet, so the lines have nothing in common if they do not.
+	return oldLength, 0
+}
+
+func appendDiffLines(diff []DiffLine, operation DiffOperation, lines []string) []DiffLine {
+	for _, line := range lines {
+		diff = append(diff, DiffLine{Operation: operation, Text: line})
+	}
+	return diff
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return []string{}
+	}
+	return strings.Split(text, "\n")
+}
@@ -1,6 +1,9 @@
 package util
 
 import (
+	"fmt"
+	"math/rand"
+	"strings"
 	"testing"
 )
 
@@ -29,3 +32,90 @@ func TestValidateEmail(t *testing.T) {
 		}
 	}
 }
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		oldText string
+		newText string
+		want    []DiffLine
+	}{
+		{
+			oldText: "",
+			newText: "hello",
+			want: []DiffLine{
+				{Operation: DiffInsert, Text: "hello"},
+			},
+		},
+		{
+			oldText: "a\nb\nc",
+			newText: "a\nc\nd",
+			want: []DiffLine{
+				{Operation: DiffEqual, Text: "a"},
+				{Operation: DiffDelete, Text: "b"},
+				{Operation: DiffEqual, Text: "c"},
+				{Operation: DiffInsert, Text: "d"},
+			},
+		},
+	}
+	for _, test := range tests {
+		result := DiffLines(test.oldText, test.newText)
+		if len(result) != len(test.want) {
+			t.Fatalf("Diff lines %q -> %q: got %v, want %v.", test.oldText, test.newText, result, test.want)
+		}
+		for i := range result {
+			if result[i] != test.want[i] {
+				t.Errorf("Diff lines %q -> %q: got %v, want %v.", test.oldText, test.newText, result, test.want)
+			}
+		}
+	}
+}
+
+func TestDiffLinesIsShortest(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+	randomText := func(length int) string {
+		lines := []string{}
+		for i := 0; i < length; i++ {
+			lines = append(lines, fmt.Sprint(random.Intn(4)))
+		}
+		return strings.Join(lines, "\n")
+	}
+	for i := 0; i < 1000; i++ {
+		oldText, newText := randomText(random.Intn(12)), randomText(random.Intn(12))
+		result := DiffLines(oldText, newText)
+		oldLines, newLines, equalCount := []string{}, []string{}, 0
+		for _, line := range result {
+			if line.Operation != DiffInsert {
+				oldLines = append(oldLines, line.Text)
+			}
+			if line.Operation != DiffDelete {
+				newLines = append(newLines, line.Text)
+			}
+			if line.Operation == DiffEqual {
+				equalCount++
+			}
+		}
+		if strings.Join(oldLines, "\n") != oldText || strings.Join(newLines, "\n") != newText {
+			t.Fatalf("Diff lines %q -> %q: got %v, which does not rebuild the texts.", oldText, newText, result)
+		}
+		if want := longestCommonLineCount(splitLines(oldText), splitLines(newText)); equalCount != want {
+			t.Fatalf("Diff lines %q -> %q: got %d equal lines, want %d.", oldText, newText, equalCount, want)
+		}
+	}
+}
+
+func longestCommonLineCount(oldLines, newLines []string) int {
+	lcs := make([][]int, len(oldLines)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(newLines)+1)
+	}
+	for i := len(oldLines) - 1; i >= 0; i-- {
+		for j := len(newLines) - 1; j >= 0; j-- {
+			if oldLines[i] == newLines[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	return lcs[0][0]
+}
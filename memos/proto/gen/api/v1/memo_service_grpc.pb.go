@@ -8,6 +8,7 @@ package apiv1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,28 +21,47 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	MemoService_CreateMemo_FullMethodName          = "/memos.api.v1.MemoService/CreateMemo"
-	MemoService_ListMemos_FullMethodName           = "/memos.api.v1.MemoService/ListMemos"
-	MemoService_SearchMemos_FullMethodName         = "/memos.api.v1.MemoService/SearchMemos"
-	MemoService_GetMemo_FullMethodName             = "/memos.api.v1.MemoService/GetMemo"
-	MemoService_UpdateMemo_FullMethodName          = "/memos.api.v1.MemoService/UpdateMemo"
-	MemoService_DeleteMemo_FullMethodName          = "/memos.api.v1.MemoService/DeleteMemo"
-	MemoService_ExportMemos_FullMethodName         = "/memos.api.v1.MemoService/ExportMemos"
-	MemoService_ListMemoProperties_FullMethodName  = "/memos.api.v1.MemoService/ListMemoProperties"
-	MemoService_RebuildMemoProperty_FullMethodName = "/memos.api.v1.MemoService/RebuildMemoProperty"
-	MemoService_ListMemoTags_FullMethodName        = "/memos.api.v1.MemoService/ListMemoTags"
-	MemoService_RenameMemoTag_FullMethodName       = "/memos.api.v1.MemoService/RenameMemoTag"
-	MemoService_DeleteMemoTag_FullMethodName       = "/memos.api.v1.MemoService/DeleteMemoTag"
-	MemoService_SetMemoResources_FullMethodName    = "/memos.api.v1.MemoService/SetMemoResources"
-	MemoService_ListMemoResources_FullMethodName   = "/memos.api.v1.MemoService/ListMemoResources"
-	MemoService_SetMemoRelations_FullMethodName    = "/memos.api.v1.MemoService/SetMemoRelations"
-	MemoService_ListMemoRelations_FullMethodName   = "/memos.api.v1.MemoService/ListMemoRelations"
-	MemoService_CreateMemoComment_FullMethodName   = "/memos.api.v1.MemoService/CreateMemoComment"
-	MemoService_ListMemoComments_FullMethodName    = "/memos.api.v1.MemoService/ListMemoComments"
-	MemoService_GetUserMemosStats_FullMethodName   = "/memos.api.v1.MemoService/GetUserMemosStats"
-	MemoService_ListMemoReactions_FullMethodName   = "/memos.api.v1.MemoService/ListMemoReactions"
-	MemoService_UpsertMemoReaction_FullMethodName  = "/memos.api.v1.MemoService/UpsertMemoReaction"
-	MemoService_DeleteMemoReaction_FullMethodName  = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_CreateMemo_FullMethodName             = "/memos.api.v1.MemoService/CreateMemo"
+	MemoService_ListMemos_FullMethodName              = "/memos.api.v1.MemoService/ListMemos"
+	MemoService_SearchMemos_FullMethodName            = "/memos.api.v1.MemoService/SearchMemos"
+	MemoService_GetMemo_FullMethodName                = "/memos.api.v1.MemoService/GetMemo"
+	MemoService_UpdateMemo_FullMethodName             = "/memos.api.v1.MemoService/UpdateMemo"
+	MemoService_DeleteMemo_FullMethodName             = "/memos.api.v1.MemoService/DeleteMemo"
+	MemoService_ExportMemos_FullMethodName            = "/memos.api.v1.MemoService/ExportMemos"
+	MemoService_ExportMemoArchive_FullMethodName      = "/memos.api.v1.MemoService/ExportMemoArchive"
+	MemoService_ImportMemos_FullMethodName            = "/memos.api.v1.MemoService/ImportMemos"
+	MemoService_ListMemoProperties_FullMethodName     = "/memos.api.v1.MemoService/ListMemoProperties"
+	MemoService_RebuildMemoProperty_FullMethodName    = "/memos.api.v1.MemoService/RebuildMemoProperty"
+	MemoService_ListMemoTags_FullMethodName           = "/memos.api.v1.MemoService/ListMemoTags"
+	MemoService_RenameMemoTag_FullMethodName          = "/memos.api.v1.MemoService/RenameMemoTag"
+	MemoService_DeleteMemoTag_FullMethodName          = "/memos.api.v1.MemoService/DeleteMemoTag"
+	MemoService_SetMemoResources_FullMethodName       = "/memos.api.v1.MemoService/SetMemoResources"
+	MemoService_ListMemoResources_FullMethodName      = "/memos.api.v1.MemoService/ListMemoResources"
+	MemoService_SetMemoRelations_FullMethodName       = "/memos.api.v1.MemoService/SetMemoRelations"
+	MemoService_ListMemoRelations_FullMethodName      = "/memos.api.v1.MemoService/ListMemoRelations"
+	MemoService_ListMemoBacklinks_FullMethodName      = "/memos.api.v1.MemoService/ListMemoBacklinks"
+	MemoService_GetMemoGraph_FullMethodName           = "/memos.api.v1.MemoService/GetMemoGraph"
+	MemoService_RebuildMemoReferences_FullMethodName  = "/memos.api.v1.MemoService/RebuildMemoReferences"
+	MemoService_ListTasks_FullMethodName              = "/memos.api.v1.MemoService/ListTasks"
+	MemoService_SetTaskCompleted_FullMethodName       = "/memos.api.v1.MemoService/SetTaskCompleted"
+	MemoService_CreateMemoShare_FullMethodName        = "/memos.api.v1.MemoService/CreateMemoShare"
+	MemoService_ListMemoShares_FullMethodName         = "/memos.api.v1.MemoService/ListMemoShares"
+	MemoService_RevokeMemoShare_FullMethodName        = "/memos.api.v1.MemoService/RevokeMemoShare"
+	MemoService_SetMemoCollaborator_FullMethodName    = "/memos.api.v1.MemoService/SetMemoCollaborator"
+	MemoService_ListMemoCollaborators_FullMethodName  = "/memos.api.v1.MemoService/ListMemoCollaborators"
+	MemoService_RemoveMemoCollaborator_FullMethodName = "/memos.api.v1.MemoService/RemoveMemoCollaborator"
+	MemoService_CreateMemoComment_FullMethodName      = "/memos.api.v1.MemoService/CreateMemoComment"
+	MemoService_ListMemoComments_FullMethodName       = "/memos.api.v1.MemoService/ListMemoComments"
+	MemoService_GetUserMemosStats_FullMethodName      = "/memos.api.v1.MemoService/GetUserMemosStats"
+	MemoService_ListMemoReactions_FullMethodName      = "/memos.api.v1.MemoService/ListMemoReactions"
+	MemoService_UpsertMemoReaction_FullMethodName     = "/memos.api.v1.MemoService/UpsertMemoReaction"
+	MemoService_DeleteMemoReaction_FullMethodName     = "/memos.api.v1.MemoService/DeleteMemoReaction"
+	MemoService_ListMemoRevisions_FullMethodName      = "/memos.api.v1.MemoService/ListMemoRevisions"
+	MemoService_GetMemoRevision_FullMethodName        = "/memos.api.v1.MemoService/GetMemoRevision"
+	MemoService_RestoreMemoRevision_FullMethodName    = "/memos.api.v1.MemoService/RestoreMemoRevision"
+	MemoService_ListTrashedMemos_FullMethodName       = "/memos.api.v1.MemoService/ListTrashedMemos"
+	MemoService_RestoreMemo_FullMethodName            = "/memos.api.v1.MemoService/RestoreMemo"
+	MemoService_PurgeMemo_FullMethodName              = "/memos.api.v1.MemoService/PurgeMemo"
 )
 
 // MemoServiceClient is the client API for MemoService service.
@@ -62,6 +82,11 @@ type MemoServiceClient interface {
 	DeleteMemo(ctx context.Context, in *DeleteMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ExportMemos exports memos.
 	ExportMemos(ctx context.Context, in *ExportMemosRequest, opts ...grpc.CallOption) (*ExportMemosResponse, error)
+	// ExportMemoArchive exports the memos of the current user along with their resources,
+	// relations, reactions and pin states as a zip archive, streamed in chunks.
+	ExportMemoArchive(ctx context.Context, in *ExportMemoArchiveRequest, opts ...grpc.CallOption) (MemoService_ExportMemoArchiveClient, error)
+	// ImportMemos imports memos from a zip archive of Markdown files.
+	ImportMemos(ctx context.Context, in *ImportMemosRequest, opts ...grpc.CallOption) (*ImportMemosResponse, error)
 	// ListMemoProperties lists memo properties.
 	ListMemoProperties(ctx context.Context, in *ListMemoPropertiesRequest, opts ...grpc.CallOption) (*ListMemoPropertiesResponse, error)
 	// RebuildMemoProperty rebuilds a memo property.
@@ -80,6 +105,28 @@ type MemoServiceClient interface {
 	SetMemoRelations(ctx context.Context, in *SetMemoRelationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(ctx context.Context, in *ListMemoRelationsRequest, opts ...grpc.CallOption) (*ListMemoRelationsResponse, error)
+	// ListMemoBacklinks lists the memos that reference a memo.
+	ListMemoBacklinks(ctx context.Context, in *ListMemoBacklinksRequest, opts ...grpc.CallOption) (*ListMemoBacklinksResponse, error)
+	// GetMemoGraph returns the memos reachable from the root memos through relations, along with the relations.
+	GetMemoGraph(ctx context.Context, in *GetMemoGraphRequest, opts ...grpc.CallOption) (*MemoGraph, error)
+	// RebuildMemoReferences creates the reference relations of the memo links in the content of memos.
+	RebuildMemoReferences(ctx context.Context, in *RebuildMemoReferencesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListTasks lists the tasks of the memos of the current user.
+	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
+	// SetTaskCompleted checks or unchecks a task in the memo content.
+	SetTaskCompleted(ctx context.Context, in *SetTaskCompletedRequest, opts ...grpc.CallOption) (*Task, error)
+	// CreateMemoShare creates an unlisted share link of a memo.
+	CreateMemoShare(ctx context.Context, in *CreateMemoShareRequest, opts ...grpc.CallOption) (*MemoShare, error)
+	// ListMemoShares lists the share links of a memo.
+	ListMemoShares(ctx context.Context, in *ListMemoSharesRequest, opts ...grpc.CallOption) (*ListMemoSharesResponse, error)
+	// RevokeMemoShare revokes a share link of a memo.
+	RevokeMemoShare(ctx context.Context, in *RevokeMemoShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// SetMemoCollaborator shares a memo with a user, or updates the permission of the user.
+	SetMemoCollaborator(ctx context.Context, in *SetMemoCollaboratorRequest, opts ...grpc.CallOption) (*MemoCollaborator, error)
+	// ListMemoCollaborators lists the users a memo is shared with.
+	ListMemoCollaborators(ctx context.Context, in *ListMemoCollaboratorsRequest, opts ...grpc.CallOption) (*ListMemoCollaboratorsResponse, error)
+	// RemoveMemoCollaborator stops sharing a memo with a user.
+	RemoveMemoCollaborator(ctx context.Context, in *RemoveMemoCollaboratorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(ctx context.Context, in *CreateMemoCommentRequest, opts ...grpc.CallOption) (*Memo, error)
 	// ListMemoComments lists comments for a memo.
@@ -92,6 +139,18 @@ type MemoServiceClient interface {
 	UpsertMemoReaction(ctx context.Context, in *UpsertMemoReactionRequest, opts ...grpc.CallOption) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(ctx context.Context, in *DeleteMemoReactionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListMemoRevisions lists revisions for a memo.
+	ListMemoRevisions(ctx context.Context, in *ListMemoRevisionsRequest, opts ...grpc.CallOption) (*ListMemoRevisionsResponse, error)
+	// GetMemoRevision gets a memo revision with its diff against the current memo content.
+	GetMemoRevision(ctx context.Context, in *GetMemoRevisionRequest, opts ...grpc.CallOption) (*GetMemoRevisionResponse, error)
+	// RestoreMemoRevision restores a memo to the given revision.
+	RestoreMemoRevision(ctx context.Context, in *RestoreMemoRevisionRequest, opts ...grpc.CallOption) (*Memo, error)
+	// ListTrashedMemos lists the memos of the current user in the trash bin.
+	ListTrashedMemos(ctx context.Context, in *ListTrashedMemosRequest, opts ...grpc.CallOption) (*ListTrashedMemosResponse, error)
+	// RestoreMemo restores a memo from the trash bin.
+	RestoreMemo(ctx context.Context, in *RestoreMemoRequest, opts ...grpc.CallOption) (*Memo, error)
+	// PurgeMemo permanently deletes a memo in the trash bin.
+	PurgeMemo(ctx context.Context, in *PurgeMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type memoServiceClient struct {
@@ -172,6 +231,49 @@ func (c *memoServiceClient) ExportMemos(ctx context.Context, in *ExportMemosRequ
 	return out, nil
 }
 
+func (c *memoServiceClient) ExportMemoArchive(ctx context.Context, in *ExportMemoArchiveRequest, opts ...grpc.CallOption) (MemoService_ExportMemoArchiveClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MemoService_ServiceDesc.Streams[0], MemoService_ExportMemoArchive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &memoServiceExportMemoArchiveClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MemoService_ExportMemoArchiveClient interface {
+	Recv() (*httpbody.HttpBody, error)
+	grpc.ClientStream
+}
+
+type memoServiceExportMemoArchiveClient struct {
+	grpc.ClientStream
+}
+
+func (x *memoServiceExportMemoArchiveClient) Recv() (*httpbody.HttpBody, error) {
+	m := new(httpbody.HttpBody)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *memoServiceClient) ImportMemos(ctx context.Context, in *ImportMemosRequest, opts ...grpc.CallOption) (*ImportMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_ImportMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) ListMemoProperties(ctx context.Context, in *ListMemoPropertiesRequest, opts ...grpc.CallOption) (*ListMemoPropertiesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListMemoPropertiesResponse)
@@ -262,6 +364,116 @@ func (c *memoServiceClient) ListMemoRelations(ctx context.Context, in *ListMemoR
 	return out, nil
 }
 
+func (c *memoServiceClient) ListMemoBacklinks(ctx context.Context, in *ListMemoBacklinksRequest, opts ...grpc.CallOption) (*ListMemoBacklinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoBacklinksResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoBacklinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoGraph(ctx context.Context, in *GetMemoGraphRequest, opts ...grpc.CallOption) (*MemoGraph, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoGraph)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RebuildMemoReferences(ctx context.Context, in *RebuildMemoReferencesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_RebuildMemoReferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTasksResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) SetTaskCompleted(ctx context.Context, in *SetTaskCompletedRequest, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, MemoService_SetTaskCompleted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) CreateMemoShare(ctx context.Context, in *CreateMemoShareRequest, opts ...grpc.CallOption) (*MemoShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoShare)
+	err := c.cc.Invoke(ctx, MemoService_CreateMemoShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoShares(ctx context.Context, in *ListMemoSharesRequest, opts ...grpc.CallOption) (*ListMemoSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoSharesResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RevokeMemoShare(ctx context.Context, in *RevokeMemoShareRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_RevokeMemoShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) SetMemoCollaborator(ctx context.Context, in *SetMemoCollaboratorRequest, opts ...grpc.CallOption) (*MemoCollaborator, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoCollaborator)
+	err := c.cc.Invoke(ctx, MemoService_SetMemoCollaborator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoCollaborators(ctx context.Context, in *ListMemoCollaboratorsRequest, opts ...grpc.CallOption) (*ListMemoCollaboratorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoCollaborators_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RemoveMemoCollaborator(ctx context.Context, in *RemoveMemoCollaboratorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_RemoveMemoCollaborator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) CreateMemoComment(ctx context.Context, in *CreateMemoCommentRequest, opts ...grpc.CallOption) (*Memo, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Memo)
@@ -322,6 +534,66 @@ func (c *memoServiceClient) DeleteMemoReaction(ctx context.Context, in *DeleteMe
 	return out, nil
 }
 
+func (c *memoServiceClient) ListMemoRevisions(ctx context.Context, in *ListMemoRevisionsRequest, opts ...grpc.CallOption) (*ListMemoRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoRevisionsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetMemoRevision(ctx context.Context, in *GetMemoRevisionRequest, opts ...grpc.CallOption) (*GetMemoRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMemoRevisionResponse)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RestoreMemoRevision(ctx context.Context, in *RestoreMemoRevisionRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_RestoreMemoRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListTrashedMemos(ctx context.Context, in *ListTrashedMemosRequest, opts ...grpc.CallOption) (*ListTrashedMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashedMemosResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListTrashedMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) RestoreMemo(ctx context.Context, in *RestoreMemoRequest, opts ...grpc.CallOption) (*Memo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Memo)
+	err := c.cc.Invoke(ctx, MemoService_RestoreMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) PurgeMemo(ctx context.Context, in *PurgeMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoService_PurgeMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MemoServiceServer is the server API for MemoService service.
 // All implementations must embed UnimplementedMemoServiceServer
 // for forward compatibility
@@ -340,6 +612,11 @@ type MemoServiceServer interface {
 	DeleteMemo(context.Context, *DeleteMemoRequest) (*emptypb.Empty, error)
 	// ExportMemos exports memos.
 	ExportMemos(context.Context, *ExportMemosRequest) (*ExportMemosResponse, error)
+	// ExportMemoArchive exports the memos of the current user along with their resources,
+	// relations, reactions and pin states as a zip archive, streamed in chunks.
+	ExportMemoArchive(*ExportMemoArchiveRequest, MemoService_ExportMemoArchiveServer) error
+	// ImportMemos imports memos from a zip archive of Markdown files.
+	ImportMemos(context.Context, *ImportMemosRequest) (*ImportMemosResponse, error)
 	// ListMemoProperties lists memo properties.
 	ListMemoProperties(context.Context, *ListMemoPropertiesRequest) (*ListMemoPropertiesResponse, error)
 	// RebuildMemoProperty rebuilds a memo property.
@@ -358,6 +635,28 @@ type MemoServiceServer interface {
 	SetMemoRelations(context.Context, *SetMemoRelationsRequest) (*emptypb.Empty, error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(context.Context, *ListMemoRelationsRequest) (*ListMemoRelationsResponse, error)
+	// ListMemoBacklinks lists the memos that reference a memo.
+	ListMemoBacklinks(context.Context, *ListMemoBacklinksRequest) (*ListMemoBacklinksResponse, error)
+	// GetMemoGraph returns the memos reachable from the root memos through relations, along with the relations.
+	GetMemoGraph(context.Context, *GetMemoGraphRequest) (*MemoGraph, error)
+	// RebuildMemoReferences creates the reference relations of the memo links in the content of memos.
+	RebuildMemoReferences(context.Context, *RebuildMemoReferencesRequest) (*emptypb.Empty, error)
+	// ListTasks lists the tasks of the memos of the current user.
+	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
+	// SetTaskCompleted checks or unchecks a task in the memo content.
+	SetTaskCompleted(context.Context, *SetTaskCompletedRequest) (*Task, error)
+	// CreateMemoShare creates an unlisted share link of a memo.
+	CreateMemoShare(context.Context, *CreateMemoShareRequest) (*MemoShare, error)
+	// ListMemoShares lists the share links of a memo.
+	ListMemoShares(context.Context, *ListMemoSharesRequest) (*ListMemoSharesResponse, error)
+	// RevokeMemoShare revokes a share link of a memo.
+	RevokeMemoShare(context.Context, *RevokeMemoShareRequest) (*emptypb.Empty, error)
+	// SetMemoCollaborator shares a memo with a user, or updates the permission of the user.
+	SetMemoCollaborator(context.Context, *SetMemoCollaboratorRequest) (*MemoCollaborator, error)
+	// ListMemoCollaborators lists the users a memo is shared with.
+	ListMemoCollaborators(context.Context, *ListMemoCollaboratorsRequest) (*ListMemoCollaboratorsResponse, error)
+	// RemoveMemoCollaborator stops sharing a memo with a user.
+	RemoveMemoCollaborator(context.Context, *RemoveMemoCollaboratorRequest) (*emptypb.Empty, error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(context.Context, *CreateMemoCommentRequest) (*Memo, error)
 	// ListMemoComments lists comments for a memo.
@@ -370,6 +669,18 @@ type MemoServiceServer interface {
 	UpsertMemoReaction(context.Context, *UpsertMemoReactionRequest) (*Reaction, error)
 	// DeleteMemoReaction deletes a reaction for a memo.
 	DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error)
+	// ListMemoRevisions lists revisions for a memo.
+	ListMemoRevisions(context.Context, *ListMemoRevisionsRequest) (*ListMemoRevisionsResponse, error)
+	// GetMemoRevision gets a memo revision with its diff against the current memo content.
+	GetMemoRevision(context.Context, *GetMemoRevisionRequest) (*GetMemoRevisionResponse, error)
+	// RestoreMemoRevision restores a memo to the given revision.
+	RestoreMemoRevision(context.Context, *RestoreMemoRevisionRequest) (*Memo, error)
+	// ListTrashedMemos lists the memos of the current user in the trash bin.
+	ListTrashedMemos(context.Context, *ListTrashedMemosRequest) (*ListTrashedMemosResponse, error)
+	// RestoreMemo restores a memo from the trash bin.
+	RestoreMemo(context.Context, *RestoreMemoRequest) (*Memo, error)
+	// PurgeMemo permanently deletes a memo in the trash bin.
+	PurgeMemo(context.Context, *PurgeMemoRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedMemoServiceServer()
 }
 
@@ -398,6 +709,12 @@ func (UnimplementedMemoServiceServer) DeleteMemo(context.Context, *DeleteMemoReq
 func (UnimplementedMemoServiceServer) ExportMemos(context.Context, *ExportMemosRequest) (*ExportMemosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExportMemos not implemented")
 }
+func (UnimplementedMemoServiceServer) ExportMemoArchive(*ExportMemoArchiveRequest, MemoService_ExportMemoArchiveServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportMemoArchive not implemented")
+}
+func (UnimplementedMemoServiceServer) ImportMemos(context.Context, *ImportMemosRequest) (*ImportMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportMemos not implemented")
+}
 func (UnimplementedMemoServiceServer) ListMemoProperties(context.Context, *ListMemoPropertiesRequest) (*ListMemoPropertiesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMemoProperties not implemented")
 }
@@ -425,6 +742,39 @@ func (UnimplementedMemoServiceServer) SetMemoRelations(context.Context, *SetMemo
 func (UnimplementedMemoServiceServer) ListMemoRelations(context.Context, *ListMemoRelationsRequest) (*ListMemoRelationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMemoRelations not implemented")
 }
+func (UnimplementedMemoServiceServer) ListMemoBacklinks(context.Context, *ListMemoBacklinksRequest) (*ListMemoBacklinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoBacklinks not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoGraph(context.Context, *GetMemoGraphRequest) (*MemoGraph, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoGraph not implemented")
+}
+func (UnimplementedMemoServiceServer) RebuildMemoReferences(context.Context, *RebuildMemoReferencesRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildMemoReferences not implemented")
+}
+func (UnimplementedMemoServiceServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasks not implemented")
+}
+func (UnimplementedMemoServiceServer) SetTaskCompleted(context.Context, *SetTaskCompletedRequest) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaskCompleted not implemented")
+}
+func (UnimplementedMemoServiceServer) CreateMemoShare(context.Context, *CreateMemoShareRequest) (*MemoShare, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMemoShare not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoShares(context.Context, *ListMemoSharesRequest) (*ListMemoSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoShares not implemented")
+}
+func (UnimplementedMemoServiceServer) RevokeMemoShare(context.Context, *RevokeMemoShareRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeMemoShare not implemented")
+}
+func (UnimplementedMemoServiceServer) SetMemoCollaborator(context.Context, *SetMemoCollaboratorRequest) (*MemoCollaborator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemoCollaborator not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoCollaborators(context.Context, *ListMemoCollaboratorsRequest) (*ListMemoCollaboratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoCollaborators not implemented")
+}
+func (UnimplementedMemoServiceServer) RemoveMemoCollaborator(context.Context, *RemoveMemoCollaboratorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMemoCollaborator not implemented")
+}
 func (UnimplementedMemoServiceServer) CreateMemoComment(context.Context, *CreateMemoCommentRequest) (*Memo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMemoComment not implemented")
 }
@@ -443,6 +793,24 @@ func (UnimplementedMemoServiceServer) UpsertMemoReaction(context.Context, *Upser
 func (UnimplementedMemoServiceServer) DeleteMemoReaction(context.Context, *DeleteMemoReactionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemoReaction not implemented")
 }
+func (UnimplementedMemoServiceServer) ListMemoRevisions(context.Context, *ListMemoRevisionsRequest) (*ListMemoRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoRevisions not implemented")
+}
+func (UnimplementedMemoServiceServer) GetMemoRevision(context.Context, *GetMemoRevisionRequest) (*GetMemoRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoRevision not implemented")
+}
+func (UnimplementedMemoServiceServer) RestoreMemoRevision(context.Context, *RestoreMemoRevisionRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMemoRevision not implemented")
+}
+func (UnimplementedMemoServiceServer) ListTrashedMemos(context.Context, *ListTrashedMemosRequest) (*ListTrashedMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedMemos not implemented")
+}
+func (UnimplementedMemoServiceServer) RestoreMemo(context.Context, *RestoreMemoRequest) (*Memo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreMemo not implemented")
+}
+func (UnimplementedMemoServiceServer) PurgeMemo(context.Context, *PurgeMemoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeMemo not implemented")
+}
 func (UnimplementedMemoServiceServer) mustEmbedUnimplementedMemoServiceServer() {}
 
 // UnsafeMemoServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -582,6 +950,45 @@ func _MemoService_ExportMemos_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ExportMemoArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportMemoArchiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MemoServiceServer).ExportMemoArchive(m, &memoServiceExportMemoArchiveServer{ServerStream: stream})
+}
+
+type MemoService_ExportMemoArchiveServer interface {
+	Send(*httpbody.HttpBody) error
+	grpc.ServerStream
+}
+
+type memoServiceExportMemoArchiveServer struct {
+	grpc.ServerStream
+}
+
+func (x *memoServiceExportMemoArchiveServer) Send(m *httpbody.HttpBody) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MemoService_ImportMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ImportMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ImportMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ImportMemos(ctx, req.(*ImportMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_ListMemoProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListMemoPropertiesRequest)
 	if err := dec(in); err != nil {
@@ -744,6 +1151,204 @@ func _MemoService_ListMemoRelations_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ListMemoBacklinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoBacklinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoBacklinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoBacklinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoBacklinks(ctx, req.(*ListMemoBacklinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoGraph(ctx, req.(*GetMemoGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RebuildMemoReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildMemoReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RebuildMemoReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RebuildMemoReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RebuildMemoReferences(ctx, req.(*RebuildMemoReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListTasks(ctx, req.(*ListTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_SetTaskCompleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaskCompletedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SetTaskCompleted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SetTaskCompleted_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SetTaskCompleted(ctx, req.(*SetTaskCompletedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_CreateMemoShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateMemoShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateMemoShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateMemoShare(ctx, req.(*CreateMemoShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoShares(ctx, req.(*ListMemoSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RevokeMemoShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeMemoShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RevokeMemoShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RevokeMemoShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RevokeMemoShare(ctx, req.(*RevokeMemoShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_SetMemoCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemoCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SetMemoCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SetMemoCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SetMemoCollaborator(ctx, req.(*SetMemoCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoCollaborators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoCollaborators(ctx, req.(*ListMemoCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RemoveMemoCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemoCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RemoveMemoCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RemoveMemoCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RemoveMemoCollaborator(ctx, req.(*RemoveMemoCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_CreateMemoComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateMemoCommentRequest)
 	if err := dec(in); err != nil {
@@ -852,6 +1457,114 @@ func _MemoService_DeleteMemoReaction_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ListMemoRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoRevisions(ctx, req.(*ListMemoRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetMemoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoRevision(ctx, req.(*GetMemoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RestoreMemoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMemoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RestoreMemoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RestoreMemoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RestoreMemoRevision(ctx, req.(*RestoreMemoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListTrashedMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashedMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListTrashedMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListTrashedMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListTrashedMemos(ctx, req.(*ListTrashedMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_RestoreMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).RestoreMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_RestoreMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).RestoreMemo(ctx, req.(*RestoreMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_PurgeMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).PurgeMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_PurgeMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).PurgeMemo(ctx, req.(*PurgeMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MemoService_ServiceDesc is the grpc.ServiceDesc for MemoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -887,6 +1600,10 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExportMemos",
 			Handler:    _MemoService_ExportMemos_Handler,
 		},
+		{
+			MethodName: "ImportMemos",
+			Handler:    _MemoService_ImportMemos_Handler,
+		},
 		{
 			MethodName: "ListMemoProperties",
 			Handler:    _MemoService_ListMemoProperties_Handler,
@@ -923,6 +1640,50 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMemoRelations",
 			Handler:    _MemoService_ListMemoRelations_Handler,
 		},
+		{
+			MethodName: "ListMemoBacklinks",
+			Handler:    _MemoService_ListMemoBacklinks_Handler,
+		},
+		{
+			MethodName: "GetMemoGraph",
+			Handler:    _MemoService_GetMemoGraph_Handler,
+		},
+		{
+			MethodName: "RebuildMemoReferences",
+			Handler:    _MemoService_RebuildMemoReferences_Handler,
+		},
+		{
+			MethodName: "ListTasks",
+			Handler:    _MemoService_ListTasks_Handler,
+		},
+		{
+			MethodName: "SetTaskCompleted",
+			Handler:    _MemoService_SetTaskCompleted_Handler,
+		},
+		{
+			MethodName: "CreateMemoShare",
+			Handler:    _MemoService_CreateMemoShare_Handler,
+		},
+		{
+			MethodName: "ListMemoShares",
+			Handler:    _MemoService_ListMemoShares_Handler,
+		},
+		{
+			MethodName: "RevokeMemoShare",
+			Handler:    _MemoService_RevokeMemoShare_Handler,
+		},
+		{
+			MethodName: "SetMemoCollaborator",
+			Handler:    _MemoService_SetMemoCollaborator_Handler,
+		},
+		{
+			MethodName: "ListMemoCollaborators",
+			Handler:    _MemoService_ListMemoCollaborators_Handler,
+		},
+		{
+			MethodName: "RemoveMemoCollaborator",
+			Handler:    _MemoService_RemoveMemoCollaborator_Handler,
+		},
 		{
 			MethodName: "CreateMemoComment",
 			Handler:    _MemoService_CreateMemoComment_Handler,
@@ -947,7 +1708,37 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteMemoReaction",
 			Handler:    _MemoService_DeleteMemoReaction_Handler,
 		},
+		{
+			MethodName: "ListMemoRevisions",
+			Handler:    _MemoService_ListMemoRevisions_Handler,
+		},
+		{
+			MethodName: "GetMemoRevision",
+			Handler:    _MemoService_GetMemoRevision_Handler,
+		},
+		{
+			MethodName: "RestoreMemoRevision",
+			Handler:    _MemoService_RestoreMemoRevision_Handler,
+		},
+		{
+			MethodName: "ListTrashedMemos",
+			Handler:    _MemoService_ListTrashedMemos_Handler,
+		},
+		{
+			MethodName: "RestoreMemo",
+			Handler:    _MemoService_RestoreMemo_Handler,
+		},
+		{
+			MethodName: "PurgeMemo",
+			Handler:    _MemoService_PurgeMemo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportMemoArchive",
+			Handler:       _MemoService_ExportMemoArchive_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/memo_service.proto",
 }
@@ -0,0 +1,273 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: api/v1/memo_template_service.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	MemoTemplateService_CreateMemoTemplate_FullMethodName = "/memos.api.v1.MemoTemplateService/CreateMemoTemplate"
+	MemoTemplateService_ListMemoTemplates_FullMethodName  = "/memos.api.v1.MemoTemplateService/ListMemoTemplates"
+	MemoTemplateService_GetMemoTemplate_FullMethodName    = "/memos.api.v1.MemoTemplateService/GetMemoTemplate"
+	MemoTemplateService_UpdateMemoTemplate_FullMethodName = "/memos.api.v1.MemoTemplateService/UpdateMemoTemplate"
+	MemoTemplateService_DeleteMemoTemplate_FullMethodName = "/memos.api.v1.MemoTemplateService/DeleteMemoTemplate"
+)
+
+// MemoTemplateServiceClient is the client API for MemoTemplateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MemoTemplateServiceClient interface {
+	// CreateMemoTemplate creates a memo template.
+	CreateMemoTemplate(ctx context.Context, in *CreateMemoTemplateRequest, opts ...grpc.CallOption) (*MemoTemplate, error)
+	// ListMemoTemplates lists the templates of the current user and the workspace.
+	ListMemoTemplates(ctx context.Context, in *ListMemoTemplatesRequest, opts ...grpc.CallOption) (*ListMemoTemplatesResponse, error)
+	// GetMemoTemplate gets a memo template.
+	GetMemoTemplate(ctx context.Context, in *GetMemoTemplateRequest, opts ...grpc.CallOption) (*MemoTemplate, error)
+	// UpdateMemoTemplate updates a memo template.
+	UpdateMemoTemplate(ctx context.Context, in *UpdateMemoTemplateRequest, opts ...grpc.CallOption) (*MemoTemplate, error)
+	// DeleteMemoTemplate deletes a memo template.
+	DeleteMemoTemplate(ctx context.Context, in *DeleteMemoTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type memoTemplateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMemoTemplateServiceClient(cc grpc.ClientConnInterface) MemoTemplateServiceClient {
+	return &memoTemplateServiceClient{cc}
+}
+
+func (c *memoTemplateServiceClient) CreateMemoTemplate(ctx context.Context, in *CreateMemoTemplateRequest, opts ...grpc.CallOption) (*MemoTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoTemplate)
+	err := c.cc.Invoke(ctx, MemoTemplateService_CreateMemoTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoTemplateServiceClient) ListMemoTemplates(ctx context.Context, in *ListMemoTemplatesRequest, opts ...grpc.CallOption) (*ListMemoTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMemoTemplatesResponse)
+	err := c.cc.Invoke(ctx, MemoTemplateService_ListMemoTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoTemplateServiceClient) GetMemoTemplate(ctx context.Context, in *GetMemoTemplateRequest, opts ...grpc.CallOption) (*MemoTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoTemplate)
+	err := c.cc.Invoke(ctx, MemoTemplateService_GetMemoTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoTemplateServiceClient) UpdateMemoTemplate(ctx context.Context, in *UpdateMemoTemplateRequest, opts ...grpc.CallOption) (*MemoTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MemoTemplate)
+	err := c.cc.Invoke(ctx, MemoTemplateService_UpdateMemoTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoTemplateServiceClient) DeleteMemoTemplate(ctx context.Context, in *DeleteMemoTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MemoTemplateService_DeleteMemoTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MemoTemplateServiceServer is the server API for MemoTemplateService service.
+// All implementations must embed UnimplementedMemoTemplateServiceServer
+// for forward compatibility
+type MemoTemplateServiceServer interface {
+	// CreateMemoTemplate creates a memo template.
+	CreateMemoTemplate(context.Context, *CreateMemoTemplateRequest) (*MemoTemplate, error)
+	// ListMemoTemplates lists the templates of the current user and the workspace.
+	ListMemoTemplates(context.Context, *ListMemoTemplatesRequest) (*ListMemoTemplatesResponse, error)
+	// GetMemoTemplate gets a memo template.
+	GetMemoTemplate(context.Context, *GetMemoTemplateRequest) (*MemoTemplate, error)
+	// UpdateMemoTemplate updates a memo template.
+	UpdateMemoTemplate(context.Context, *UpdateMemoTemplateRequest) (*MemoTemplate, error)
+	// DeleteMemoTemplate deletes a memo template.
+	DeleteMemoTemplate(context.Context, *DeleteMemoTemplateRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedMemoTemplateServiceServer()
+}
+
+// UnimplementedMemoTemplateServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMemoTemplateServiceServer struct {
+}
+
+func (UnimplementedMemoTemplateServiceServer) CreateMemoTemplate(context.Context, *CreateMemoTemplateRequest) (*MemoTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMemoTemplate not implemented")
+}
+func (UnimplementedMemoTemplateServiceServer) ListMemoTemplates(context.Context, *ListMemoTemplatesRequest) (*ListMemoTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoTemplates not implemented")
+}
+func (UnimplementedMemoTemplateServiceServer) GetMemoTemplate(context.Context, *GetMemoTemplateRequest) (*MemoTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoTemplate not implemented")
+}
+func (UnimplementedMemoTemplateServiceServer) UpdateMemoTemplate(context.Context, *UpdateMemoTemplateRequest) (*MemoTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMemoTemplate not implemented")
+}
+func (UnimplementedMemoTemplateServiceServer) DeleteMemoTemplate(context.Context, *DeleteMemoTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemoTemplate not implemented")
+}
+func (UnimplementedMemoTemplateServiceServer) mustEmbedUnimplementedMemoTemplateServiceServer() {}
+
+// UnsafeMemoTemplateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MemoTemplateServiceServer will
+// result in compilation errors.
+type UnsafeMemoTemplateServiceServer interface {
+	mustEmbedUnimplementedMemoTemplateServiceServer()
+}
+
+func RegisterMemoTemplateServiceServer(s grpc.ServiceRegistrar, srv MemoTemplateServiceServer) {
+	s.RegisterService(&MemoTemplateService_ServiceDesc, srv)
+}
+
+func _MemoTemplateService_CreateMemoTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoTemplateServiceServer).CreateMemoTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoTemplateService_CreateMemoTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoTemplateServiceServer).CreateMemoTemplate(ctx, req.(*CreateMemoTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoTemplateService_ListMemoTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoTemplateServiceServer).ListMemoTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoTemplateService_ListMemoTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoTemplateServiceServer).ListMemoTemplates(ctx, req.(*ListMemoTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoTemplateService_GetMemoTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoTemplateServiceServer).GetMemoTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoTemplateService_GetMemoTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoTemplateServiceServer).GetMemoTemplate(ctx, req.(*GetMemoTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoTemplateService_UpdateMemoTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMemoTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoTemplateServiceServer).UpdateMemoTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoTemplateService_UpdateMemoTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoTemplateServiceServer).UpdateMemoTemplate(ctx, req.(*UpdateMemoTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoTemplateService_DeleteMemoTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoTemplateServiceServer).DeleteMemoTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoTemplateService_DeleteMemoTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoTemplateServiceServer).DeleteMemoTemplate(ctx, req.(*DeleteMemoTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MemoTemplateService_ServiceDesc is the grpc.ServiceDesc for MemoTemplateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MemoTemplateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.MemoTemplateService",
+	HandlerType: (*MemoTemplateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMemoTemplate",
+			Handler:    _MemoTemplateService_CreateMemoTemplate_Handler,
+		},
+		{
+			MethodName: "ListMemoTemplates",
+			Handler:    _MemoTemplateService_ListMemoTemplates_Handler,
+		},
+		{
+			MethodName: "GetMemoTemplate",
+			Handler:    _MemoTemplateService_GetMemoTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateMemoTemplate",
+			Handler:    _MemoTemplateService_UpdateMemoTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteMemoTemplate",
+			Handler:    _MemoTemplateService_DeleteMemoTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/memo_template_service.proto",
+}
@@ -0,0 +1,313 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: api/v1/recurring_memo_service.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	RecurringMemoService_CreateRecurringMemo_FullMethodName          = "/memos.api.v1.RecurringMemoService/CreateRecurringMemo"
+	RecurringMemoService_ListRecurringMemos_FullMethodName           = "/memos.api.v1.RecurringMemoService/ListRecurringMemos"
+	RecurringMemoService_PauseRecurringMemo_FullMethodName           = "/memos.api.v1.RecurringMemoService/PauseRecurringMemo"
+	RecurringMemoService_ResumeRecurringMemo_FullMethodName          = "/memos.api.v1.RecurringMemoService/ResumeRecurringMemo"
+	RecurringMemoService_DeleteRecurringMemo_FullMethodName          = "/memos.api.v1.RecurringMemoService/DeleteRecurringMemo"
+	RecurringMemoService_PreviewRecurringMemoSchedule_FullMethodName = "/memos.api.v1.RecurringMemoService/PreviewRecurringMemoSchedule"
+)
+
+// RecurringMemoServiceClient is the client API for RecurringMemoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RecurringMemoServiceClient interface {
+	// CreateRecurringMemo registers a schedule to create memos or send reminders on.
+	CreateRecurringMemo(ctx context.Context, in *CreateRecurringMemoRequest, opts ...grpc.CallOption) (*RecurringMemo, error)
+	// ListRecurringMemos lists the recurring memos of the current user.
+	ListRecurringMemos(ctx context.Context, in *ListRecurringMemosRequest, opts ...grpc.CallOption) (*ListRecurringMemosResponse, error)
+	// PauseRecurringMemo pauses a recurring memo, which is skipped until resumed.
+	PauseRecurringMemo(ctx context.Context, in *PauseRecurringMemoRequest, opts ...grpc.CallOption) (*RecurringMemo, error)
+	// ResumeRecurringMemo resumes a paused recurring memo from now on, without catching up the missed runs.
+	ResumeRecurringMemo(ctx context.Context, in *ResumeRecurringMemoRequest, opts ...grpc.CallOption) (*RecurringMemo, error)
+	// DeleteRecurringMemo deletes a recurring memo.
+	DeleteRecurringMemo(ctx context.Context, in *DeleteRecurringMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PreviewRecurringMemoSchedule returns the next run times of a cron expression.
+	PreviewRecurringMemoSchedule(ctx context.Context, in *PreviewRecurringMemoScheduleRequest, opts ...grpc.CallOption) (*PreviewRecurringMemoScheduleResponse, error)
+}
+
+type recurringMemoServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecurringMemoServiceClient(cc grpc.ClientConnInterface) RecurringMemoServiceClient {
+	return &recurringMemoServiceClient{cc}
+}
+
+func (c *recurringMemoServiceClient) CreateRecurringMemo(ctx context.Context, in *CreateRecurringMemoRequest, opts ...grpc.CallOption) (*RecurringMemo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringMemo)
+	err := c.cc.Invoke(ctx, RecurringMemoService_CreateRecurringMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringMemoServiceClient) ListRecurringMemos(ctx context.Context, in *ListRecurringMemosRequest, opts ...grpc.CallOption) (*ListRecurringMemosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRecurringMemosResponse)
+	err := c.cc.Invoke(ctx, RecurringMemoService_ListRecurringMemos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringMemoServiceClient) PauseRecurringMemo(ctx context.Context, in *PauseRecurringMemoRequest, opts ...grpc.CallOption) (*RecurringMemo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringMemo)
+	err := c.cc.Invoke(ctx, RecurringMemoService_PauseRecurringMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringMemoServiceClient) ResumeRecurringMemo(ctx context.Context, in *ResumeRecurringMemoRequest, opts ...grpc.CallOption) (*RecurringMemo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecurringMemo)
+	err := c.cc.Invoke(ctx, RecurringMemoService_ResumeRecurringMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringMemoServiceClient) DeleteRecurringMemo(ctx context.Context, in *DeleteRecurringMemoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RecurringMemoService_DeleteRecurringMemo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringMemoServiceClient) PreviewRecurringMemoSchedule(ctx context.Context, in *PreviewRecurringMemoScheduleRequest, opts ...grpc.CallOption) (*PreviewRecurringMemoScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewRecurringMemoScheduleResponse)
+	err := c.cc.Invoke(ctx, RecurringMemoService_PreviewRecurringMemoSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecurringMemoServiceServer is the server API for RecurringMemoService service.
+// All implementations must embed UnimplementedRecurringMemoServiceServer
+// for forward compatibility
+type RecurringMemoServiceServer interface {
+	// CreateRecurringMemo registers a schedule to create memos or send reminders on.
+	CreateRecurringMemo(context.Context, *CreateRecurringMemoRequest) (*RecurringMemo, error)
+	// ListRecurringMemos lists the recurring memos of the current user.
+	ListRecurringMemos(context.Context, *ListRecurringMemosRequest) (*ListRecurringMemosResponse, error)
+	// PauseRecurringMemo pauses a recurring memo, which is skipped until resumed.
+	PauseRecurringMemo(context.Context, *PauseRecurringMemoRequest) (*RecurringMemo, error)
+	// ResumeRecurringMemo resumes a paused recurring memo from now on, without catching up the missed runs.
+	ResumeRecurringMemo(context.Context, *ResumeRecurringMemoRequest) (*RecurringMemo, error)
+	// DeleteRecurringMemo deletes a recurring memo.
+	DeleteRecurringMemo(context.Context, *DeleteRecurringMemoRequest) (*emptypb.Empty, error)
+	// PreviewRecurringMemoSchedule returns the next run times of a cron expression.
+	PreviewRecurringMemoSchedule(context.Context, *PreviewRecurringMemoScheduleRequest) (*PreviewRecurringMemoScheduleResponse, error)
+	mustEmbedUnimplementedRecurringMemoServiceServer()
+}
+
+// UnimplementedRecurringMemoServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRecurringMemoServiceServer struct {
+}
+
+func (UnimplementedRecurringMemoServiceServer) CreateRecurringMemo(context.Context, *CreateRecurringMemoRequest) (*RecurringMemo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringMemo not implemented")
+}
+func (UnimplementedRecurringMemoServiceServer) ListRecurringMemos(context.Context, *ListRecurringMemosRequest) (*ListRecurringMemosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecurringMemos not implemented")
+}
+func (UnimplementedRecurringMemoServiceServer) PauseRecurringMemo(context.Context, *PauseRecurringMemoRequest) (*RecurringMemo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRecurringMemo not implemented")
+}
+func (UnimplementedRecurringMemoServiceServer) ResumeRecurringMemo(context.Context, *ResumeRecurringMemoRequest) (*RecurringMemo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeRecurringMemo not implemented")
+}
+func (UnimplementedRecurringMemoServiceServer) DeleteRecurringMemo(context.Context, *DeleteRecurringMemoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurringMemo not implemented")
+}
+func (UnimplementedRecurringMemoServiceServer) PreviewRecurringMemoSchedule(context.Context, *PreviewRecurringMemoScheduleRequest) (*PreviewRecurringMemoScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewRecurringMemoSchedule not implemented")
+}
+func (UnimplementedRecurringMemoServiceServer) mustEmbedUnimplementedRecurringMemoServiceServer() {}
+
+// UnsafeRecurringMemoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecurringMemoServiceServer will
+// result in compilation errors.
+type UnsafeRecurringMemoServiceServer interface {
+	mustEmbedUnimplementedRecurringMemoServiceServer()
+}
+
+func RegisterRecurringMemoServiceServer(s grpc.ServiceRegistrar, srv RecurringMemoServiceServer) {
+	s.RegisterService(&RecurringMemoService_ServiceDesc, srv)
+}
+
+func _RecurringMemoService_CreateRecurringMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringMemoServiceServer).CreateRecurringMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringMemoService_CreateRecurringMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringMemoServiceServer).CreateRecurringMemo(ctx, req.(*CreateRecurringMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringMemoService_ListRecurringMemos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecurringMemosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringMemoServiceServer).ListRecurringMemos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringMemoService_ListRecurringMemos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringMemoServiceServer).ListRecurringMemos(ctx, req.(*ListRecurringMemosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringMemoService_PauseRecurringMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRecurringMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringMemoServiceServer).PauseRecurringMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringMemoService_PauseRecurringMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringMemoServiceServer).PauseRecurringMemo(ctx, req.(*PauseRecurringMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringMemoService_ResumeRecurringMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRecurringMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringMemoServiceServer).ResumeRecurringMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringMemoService_ResumeRecurringMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringMemoServiceServer).ResumeRecurringMemo(ctx, req.(*ResumeRecurringMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringMemoService_DeleteRecurringMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurringMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringMemoServiceServer).DeleteRecurringMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringMemoService_DeleteRecurringMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringMemoServiceServer).DeleteRecurringMemo(ctx, req.(*DeleteRecurringMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringMemoService_PreviewRecurringMemoSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewRecurringMemoScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringMemoServiceServer).PreviewRecurringMemoSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RecurringMemoService_PreviewRecurringMemoSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringMemoServiceServer).PreviewRecurringMemoSchedule(ctx, req.(*PreviewRecurringMemoScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecurringMemoService_ServiceDesc is the grpc.ServiceDesc for RecurringMemoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecurringMemoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.RecurringMemoService",
+	HandlerType: (*RecurringMemoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRecurringMemo",
+			Handler:    _RecurringMemoService_CreateRecurringMemo_Handler,
+		},
+		{
+			MethodName: "ListRecurringMemos",
+			Handler:    _RecurringMemoService_ListRecurringMemos_Handler,
+		},
+		{
+			MethodName: "PauseRecurringMemo",
+			Handler:    _RecurringMemoService_PauseRecurringMemo_Handler,
+		},
+		{
+			MethodName: "ResumeRecurringMemo",
+			Handler:    _RecurringMemoService_ResumeRecurringMemo_Handler,
+		},
+		{
+			MethodName: "DeleteRecurringMemo",
+			Handler:    _RecurringMemoService_DeleteRecurringMemo_Handler,
+		},
+		{
+			MethodName: "PreviewRecurringMemoSchedule",
+			Handler:    _RecurringMemoService_PreviewRecurringMemoSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/recurring_memo_service.proto",
+}
@@ -0,0 +1,233 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.4.0
+// - protoc             (unknown)
+// source: api/v1/reminder_service.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.62.0 or later.
+const _ = grpc.SupportPackageIsVersion8
+
+const (
+	ReminderService_SetReminder_FullMethodName    = "/memos.api.v1.ReminderService/SetReminder"
+	ReminderService_ListReminders_FullMethodName  = "/memos.api.v1.ReminderService/ListReminders"
+	ReminderService_SnoozeReminder_FullMethodName = "/memos.api.v1.ReminderService/SnoozeReminder"
+	ReminderService_DeleteReminder_FullMethodName = "/memos.api.v1.ReminderService/DeleteReminder"
+)
+
+// ReminderServiceClient is the client API for ReminderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReminderServiceClient interface {
+	// SetReminder sets the reminder of a memo or of a task of the memo, replacing the pending one if any.
+	SetReminder(ctx context.Context, in *SetReminderRequest, opts ...grpc.CallOption) (*Reminder, error)
+	// ListReminders lists the reminders of the current user.
+	ListReminders(ctx context.Context, in *ListRemindersRequest, opts ...grpc.CallOption) (*ListRemindersResponse, error)
+	// SnoozeReminder postpones a reminder, which fires again if it already fired.
+	SnoozeReminder(ctx context.Context, in *SnoozeReminderRequest, opts ...grpc.CallOption) (*Reminder, error)
+	// DeleteReminder deletes a reminder.
+	DeleteReminder(ctx context.Context, in *DeleteReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type reminderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReminderServiceClient(cc grpc.ClientConnInterface) ReminderServiceClient {
+	return &reminderServiceClient{cc}
+}
+
+func (c *reminderServiceClient) SetReminder(ctx context.Context, in *SetReminderRequest, opts ...grpc.CallOption) (*Reminder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reminder)
+	err := c.cc.Invoke(ctx, ReminderService_SetReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) ListReminders(ctx context.Context, in *ListRemindersRequest, opts ...grpc.CallOption) (*ListRemindersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRemindersResponse)
+	err := c.cc.Invoke(ctx, ReminderService_ListReminders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) SnoozeReminder(ctx context.Context, in *SnoozeReminderRequest, opts ...grpc.CallOption) (*Reminder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Reminder)
+	err := c.cc.Invoke(ctx, ReminderService_SnoozeReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reminderServiceClient) DeleteReminder(ctx context.Context, in *DeleteReminderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ReminderService_DeleteReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReminderServiceServer is the server API for ReminderService service.
+// All implementations must embed UnimplementedReminderServiceServer
+// for forward compatibility
+type ReminderServiceServer interface {
+	// SetReminder sets the reminder of a memo or of a task of the memo, replacing the pending one if any.
+	SetReminder(context.Context, *SetReminderRequest) (*Reminder, error)
+	// ListReminders lists the reminders of the current user.
+	ListReminders(context.Context, *ListRemindersRequest) (*ListRemindersResponse, error)
+	// SnoozeReminder postpones a reminder, which fires again if it already fired.
+	SnoozeReminder(context.Context, *SnoozeReminderRequest) (*Reminder, error)
+	// DeleteReminder deletes a reminder.
+	DeleteReminder(context.Context, *DeleteReminderRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedReminderServiceServer()
+}
+
+// UnimplementedReminderServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedReminderServiceServer struct {
+}
+
+func (UnimplementedReminderServiceServer) SetReminder(context.Context, *SetReminderRequest) (*Reminder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) ListReminders(context.Context, *ListRemindersRequest) (*ListRemindersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReminders not implemented")
+}
+func (UnimplementedReminderServiceServer) SnoozeReminder(context.Context, *SnoozeReminderRequest) (*Reminder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnoozeReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) DeleteReminder(context.Context, *DeleteReminderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReminder not implemented")
+}
+func (UnimplementedReminderServiceServer) mustEmbedUnimplementedReminderServiceServer() {}
+
+// UnsafeReminderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReminderServiceServer will
+// result in compilation errors.
+type UnsafeReminderServiceServer interface {
+	mustEmbedUnimplementedReminderServiceServer()
+}
+
+func RegisterReminderServiceServer(s grpc.ServiceRegistrar, srv ReminderServiceServer) {
+	s.RegisterService(&ReminderService_ServiceDesc, srv)
+}
+
+func _ReminderService_SetReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).SetReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_SetReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).SetReminder(ctx, req.(*SetReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_ListReminders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRemindersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).ListReminders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_ListReminders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).ListReminders(ctx, req.(*ListRemindersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_SnoozeReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnoozeReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).SnoozeReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_SnoozeReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).SnoozeReminder(ctx, req.(*SnoozeReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReminderService_DeleteReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReminderServiceServer).DeleteReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReminderService_DeleteReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReminderServiceServer).DeleteReminder(ctx, req.(*DeleteReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReminderService_ServiceDesc is the grpc.ServiceDesc for ReminderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReminderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "memos.api.v1.ReminderService",
+	HandlerType: (*ReminderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetReminder",
+			Handler:    _ReminderService_SetReminder_Handler,
+		},
+		{
+			MethodName: "ListReminders",
+			Handler:    _ReminderService_ListReminders_Handler,
+		},
+		{
+			MethodName: "SnoozeReminder",
+			Handler:    _ReminderService_SnoozeReminder_Handler,
+		},
+		{
+			MethodName: "DeleteReminder",
+			Handler:    _ReminderService_DeleteReminder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/reminder_service.proto",
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -25,6 +27,8 @@ const (
 	// user id is extracted from the jwt token subject field.
 	usernameContextKey ContextKey = iota
 	accessTokenContextKey
+	// The key name used to store the memo share link granted by the share token of the request.
+	memoShareLinkContextKey
 )
 
 // GRPCAuthInterceptor is the auth interceptor for gRPC server.
@@ -43,6 +47,29 @@ func NewGRPCAuthInterceptor(store *store.Store, secret string) *GRPCAuthIntercep
 
 // AuthenticationInterceptor is the unary interceptor for gRPC API.
 func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := in.authenticateContext(ctx, serverInfo.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	if isMemoShareAllowedMethod(serverInfo.FullMethod) {
+		if ctx, err = in.authenticateMemoShare(ctx, request); err != nil {
+			return nil, err
+		}
+	}
+	return handler(ctx, request)
+}
+
+// StreamAuthenticationInterceptor is the stream interceptor for gRPC API.
+func (in *GRPCAuthInterceptor) StreamAuthenticationInterceptor(srv any, stream grpc.ServerStream, serverInfo *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := in.authenticateContext(stream.Context(), serverInfo.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedServerStream{ServerStream: stream, ctx: ctx})
+}
+
+// authenticateContext returns the context with the authenticated user of the request.
+func (in *GRPCAuthInterceptor) authenticateContext(ctx context.Context, fullMethod string) (context.Context, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, status.Errorf(codes.Unauthenticated, "failed to parse metadata from incoming context")
@@ -54,8 +81,8 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 
 	username, err := in.authenticate(ctx, accessToken)
 	if err != nil {
-		if isUnauthorizeAllowedMethod(serverInfo.FullMethod) {
-			return handler(ctx, request)
+		if isUnauthorizeAllowedMethod(fullMethod) {
+			return ctx, nil
 		}
 		return nil, err
 	}
@@ -71,13 +98,56 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 	if user.RowStatus == store.Archived {
 		return nil, errors.Errorf("user %q is archived", username)
 	}
-	if isOnlyForAdminAllowedMethod(serverInfo.FullMethod) && user.Role != store.RoleHost && user.Role != store.RoleAdmin {
+	if isOnlyForAdminAllowedMethod(fullMethod) && user.Role != store.RoleHost && user.Role != store.RoleAdmin {
 		return nil, errors.Errorf("user %q is not admin", username)
 	}
 
 	ctx = context.WithValue(ctx, usernameContextKey, username)
 	ctx = context.WithValue(ctx, accessTokenContextKey, accessToken)
-	return handler(ctx, request)
+	return ctx, nil
+}
+
+// memoShareRequest is a request that carries the token of a memo share link.
+type memoShareRequest interface {
+	GetShareToken() string
+	GetSharePassword() string
+}
+
+// authenticateMemoShare returns the context with the memo share link granted by the share token of the request, if any.
+func (in *GRPCAuthInterceptor) authenticateMemoShare(ctx context.Context, request any) (context.Context, error) {
+	shareRequest, ok := request.(memoShareRequest)
+	if !ok || shareRequest.GetShareToken() == "" {
+		return ctx, nil
+	}
+	token := shareRequest.GetShareToken()
+	link, err := in.Store.GetMemoShareLink(ctx, &store.FindMemoShareLink{
+		Token: &token,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo share link: %v", err)
+	}
+	if link == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid share token")
+	}
+	if link.ExpireTs != 0 && link.ExpireTs <= time.Now().Unix() {
+		return nil, status.Errorf(codes.PermissionDenied, "share link expired")
+	}
+	if link.PasswordHash != "" {
+		if err := bcrypt.CompareHashAndPassword([]byte(link.PasswordHash), []byte(shareRequest.GetSharePassword())); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "invalid share password")
+		}
+	}
+	return context.WithValue(ctx, memoShareLinkContextKey, link), nil
+}
+
+// authenticatedServerStream is a server stream with the authenticated context.
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedServerStream) Context() context.Context {
+	return s.ctx
 }
 
 func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken string) (string, error) {
@@ -17,8 +17,13 @@ var authenticationAllowlistMethods = map[string]bool{
 	"/memos.api.v1.MemoService/GetMemo":                           true,
 	"/memos.api.v1.MemoService/ListMemos":                         true,
 	"/memos.api.v1.MemoService/ListMemoTags":                      true,
+	"/memos.api.v1.MemoService/ListMemoBacklinks":                 true,
+	"/memos.api.v1.MemoService/GetMemoGraph":                      true,
 	"/memos.api.v1.MemoService/SearchMemos":                       true,
 	"/memos.api.v1.MarkdownService/GetLinkMetadata":               true,
+	"/memos.api.v1.CollectionService/ListCollections":             true,
+	"/memos.api.v1.CollectionService/GetCollection":               true,
+	"/memos.api.v1.CollectionService/ListCollectionMemos":         true,
 	"/memos.api.v1.ResourceService/GetResourceBinary":             true,
 }
 
@@ -27,6 +32,17 @@ func isUnauthorizeAllowedMethod(fullMethodName string) bool {
 	return authenticationAllowlistMethods[fullMethodName]
 }
 
+// memoShareAllowedMethods are the methods that accept the token of a memo share link.
+var memoShareAllowedMethods = map[string]bool{
+	"/memos.api.v1.MemoService/GetMemo":               true,
+	"/memos.api.v1.ResourceService/GetResourceBinary": true,
+}
+
+// isMemoShareAllowedMethod returns whether the method grants access to shared memos by their share tokens.
+func isMemoShareAllowedMethod(fullMethodName string) bool {
+	return memoShareAllowedMethods[fullMethodName]
+}
+
 var allowedMethodsOnlyForAdmin = map[string]bool{
 	"/memos.api.v1.UserService/CreateUser":                      true,
 	"/memos.api.v1.WorkspaceSettingService/SetWorkspaceSetting": true,
@@ -52,5 +52,19 @@ func convertActivityPayloadFromStore(payload *storepb.ActivityPayload) *v1pb.Act
 			Version: payload.VersionUpdate.Version,
 		}
 	}
+	if payload.Reminder != nil {
+		v2Payload.Reminder = &v1pb.ActivityReminderPayload{
+			ReminderId:      payload.Reminder.ReminderId,
+			MemoId:          payload.Reminder.MemoId,
+			TaskPosition:    payload.Reminder.TaskPosition,
+			RecurringMemoId: payload.Reminder.RecurringMemoId,
+		}
+	}
+	if payload.MemoShare != nil {
+		v2Payload.MemoShare = &v1pb.ActivityMemoSharePayload{
+			MemoId:     payload.MemoShare.MemoId,
+			Permission: payload.MemoShare.Permission,
+		}
+	}
 	return v2Payload
 }
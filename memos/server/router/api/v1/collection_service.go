@@ -0,0 +1,375 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) CreateCollection(ctx context.Context, request *v1pb.CreateCollectionRequest) (*v1pb.Collection, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if request.Collection == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "collection is required")
+	}
+	if request.Collection.Title == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "title is required")
+	}
+	visibility, err := s.convertCollectionVisibilityToStore(ctx, request.Collection.Visibility)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := s.Store.CreateCollection(ctx, &store.Collection{
+		CreatorID:   user.ID,
+		Title:       request.Collection.Title,
+		Description: request.Collection.Description,
+		Visibility:  visibility,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create collection: %v", err)
+	}
+	return convertCollectionFromStore(collection), nil
+}
+
+func (s *APIV1Service) ListCollections(ctx context.Context, request *v1pb.ListCollectionsRequest) (*v1pb.ListCollectionsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+
+	collectionFind := &store.FindCollection{}
+	if request.Creator != "" {
+		creatorID, err := ExtractUserIDFromName(request.Creator)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+		}
+		collectionFind.CreatorID = &creatorID
+	} else {
+		if user == nil {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		collectionFind.CreatorID = &user.ID
+	}
+	if user == nil {
+		collectionFind.VisibilityList = []store.Visibility{store.Public}
+	} else if *collectionFind.CreatorID != user.ID {
+		collectionFind.VisibilityList = []store.Visibility{store.Public, store.Protected}
+	}
+
+	collections, err := s.Store.ListCollections(ctx, collectionFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collections: %v", err)
+	}
+	response := &v1pb.ListCollectionsResponse{
+		Collections: []*v1pb.Collection{},
+	}
+	for _, collection := range collections {
+		response.Collections = append(response.Collections, convertCollectionFromStore(collection))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetCollection(ctx context.Context, request *v1pb.GetCollectionRequest) (*v1pb.Collection, error) {
+	collectionID, err := ExtractCollectionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid collection name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	collection, err := s.getVisibleCollection(ctx, collectionID, user)
+	if err != nil {
+		return nil, err
+	}
+	return convertCollectionFromStore(collection), nil
+}
+
+func (s *APIV1Service) UpdateCollection(ctx context.Context, request *v1pb.UpdateCollectionRequest) (*v1pb.Collection, error) {
+	if request.Collection == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "collection is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	collection, err := s.getCurrentUserCollection(ctx, request.Collection.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedTs := time.Now().Unix()
+	update := &store.UpdateCollection{
+		ID:        collection.ID,
+		UpdatedTs: &updatedTs,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "title":
+			if request.Collection.Title == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "title is required")
+			}
+			update.Title = &request.Collection.Title
+		case "description":
+			update.Description = &request.Collection.Description
+		case "visibility":
+			visibility, err := s.convertCollectionVisibilityToStore(ctx, request.Collection.Visibility)
+			if err != nil {
+				return nil, err
+			}
+			update.Visibility = &visibility
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update path %q", path)
+		}
+	}
+
+	collection, err = s.Store.UpdateCollection(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update collection: %v", err)
+	}
+	return convertCollectionFromStore(collection), nil
+}
+
+func (s *APIV1Service) DeleteCollection(ctx context.Context, request *v1pb.DeleteCollectionRequest) (*emptypb.Empty, error) {
+	collection, err := s.getCurrentUserCollection(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.WithTx(ctx, func(txStore *store.Store) error {
+		if err := txStore.DeleteCollectionMemo(ctx, &store.DeleteCollectionMemo{CollectionID: &collection.ID}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete collection memos: %v", err)
+		}
+		if err := txStore.DeleteCollection(ctx, &store.DeleteCollection{ID: collection.ID}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete collection: %v", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *APIV1Service) ListCollectionMemos(ctx context.Context, request *v1pb.ListCollectionMemosRequest) (*v1pb.ListCollectionMemosResponse, error) {
+	collectionID, err := ExtractCollectionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid collection name: %v", err)
+	}
+	// The memos are found by the collection filter, so that they follow the same visibility rules as ListMemos.
+	memoFind := &store.FindMemo{}
+	if err := s.buildMemoFindWithFilter(ctx, memoFind, fmt.Sprintf("collection == %q", fmt.Sprintf("%s%d", CollectionNamePrefix, collectionID))); err != nil {
+		return nil, err
+	}
+	limit, _, cursor, err := parsePageToken(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	collectionMemos, err := s.Store.ListCollectionMemos(ctx, &store.FindCollectionMemo{CollectionID: &collectionID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list collection memos: %v", err)
+	}
+	positions := map[int32]int32{}
+	for _, collectionMemo := range collectionMemos {
+		positions[collectionMemo.MemoID] = collectionMemo.Position
+	}
+	if cursor != nil {
+		collectionMemos = slices.DeleteFunc(collectionMemos, func(collectionMemo *store.CollectionMemo) bool {
+			return collectionMemo.Position <= cursor.Position
+		})
+	}
+
+	// The memos are listed in batches of the size of a page in the collection order, until the page is filled.
+	memos := []*store.Memo{}
+	for len(memos) <= limit && len(collectionMemos) > 0 {
+		batch := collectionMemos[:min(limit+1, len(collectionMemos))]
+		collectionMemos = collectionMemos[len(batch):]
+		memoFind.IDList = []int32{}
+		for _, collectionMemo := range batch {
+			memoFind.IDList = append(memoFind.IDList, collectionMemo.MemoID)
+		}
+		batchMemos, err := s.Store.ListMemos(ctx, memoFind)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		}
+		slices.SortFunc(batchMemos, func(a, b *store.Memo) int {
+			return int(positions[a.ID] - positions[b.ID])
+		})
+		memos = append(memos, batchMemos...)
+	}
+
+	nextPageToken := ""
+	if len(memos) > limit {
+		memos = memos[:limit]
+		nextPageToken, err = getCursorPageToken(limit, &v1pb.PageCursor{
+			Position: positions[memos[len(memos)-1].ID],
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert memos: %v", err)
+	}
+	return &v1pb.ListCollectionMemosResponse{
+		Memos:         memoMessages,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *APIV1Service) SetCollectionMemos(ctx context.Context, request *v1pb.SetCollectionMemosRequest) (*emptypb.Empty, error) {
+	collection, err := s.getCurrentUserCollection(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+
+	memoIDs := []int32{}
+	for _, name := range request.Memos {
+		memoID, err := ExtractMemoIDFromName(name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+		}
+		if slices.Contains(memoIDs, memoID) {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicate memo %q", name)
+		}
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &memoID,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return nil, status.Errorf(codes.NotFound, "memo %q not found", name)
+		}
+		// Only the memos the user can see can be added to a collection.
+		if !isMemoVisible(memo, user) {
+			shared, err := s.hasMemoSharePermission(ctx, memo, user, store.MemoSharePermissionRead)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+			}
+			if !shared {
+				return nil, status.Errorf(codes.NotFound, "memo %q not found", name)
+			}
+		}
+		memoIDs = append(memoIDs, memoID)
+	}
+
+	if err := s.Store.WithTx(ctx, func(txStore *store.Store) error {
+		if err := txStore.DeleteCollectionMemo(ctx, &store.DeleteCollectionMemo{CollectionID: &collection.ID}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete collection memos: %v", err)
+		}
+		for position, memoID := range memoIDs {
+			if _, err := txStore.UpsertCollectionMemo(ctx, &store.CollectionMemo{
+				CollectionID: collection.ID,
+				MemoID:       memoID,
+				Position:     int32(position),
+			}); err != nil {
+				return status.Errorf(codes.Internal, "failed to upsert collection memo: %v", err)
+			}
+		}
+		updatedTs := time.Now().Unix()
+		if _, err := txStore.UpdateCollection(ctx, &store.UpdateCollection{
+			ID:        collection.ID,
+			UpdatedTs: &updatedTs,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to update collection: %v", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getVisibleCollection returns the collection with the given ID, which must be visible to the user.
+// The user is nil if not signed in. Invisible collections are reported as missing.
+func (s *APIV1Service) getVisibleCollection(ctx context.Context, collectionID int32, user *store.User) (*store.Collection, error) {
+	collection, err := s.Store.GetCollection(ctx, &store.FindCollection{ID: &collectionID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get collection: %v", err)
+	}
+	if collection == nil || !isCollectionVisible(collection, user) {
+		return nil, status.Errorf(codes.NotFound, "collection not found")
+	}
+	return collection, nil
+}
+
+// getCurrentUserCollection returns the collection with the given name, which must be owned by the current user.
+func (s *APIV1Service) getCurrentUserCollection(ctx context.Context, name string) (*store.Collection, error) {
+	collectionID, err := ExtractCollectionIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid collection name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	collection, err := s.getVisibleCollection(ctx, collectionID, user)
+	if err != nil {
+		return nil, err
+	}
+	if collection.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return collection, nil
+}
+
+// convertCollectionVisibilityToStore returns the visibility of a collection, which follows the workspace setting of memos.
+func (s *APIV1Service) convertCollectionVisibilityToStore(ctx context.Context, visibility v1pb.Visibility) (store.Visibility, error) {
+	collectionVisibility := convertVisibilityToStore(visibility)
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get workspace memo related setting")
+	}
+	if workspaceMemoRelatedSetting.DisallowPublicVisible && collectionVisibility == store.Public {
+		return "", status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+	}
+	return collectionVisibility, nil
+}
+
+// isCollectionVisible returns whether the collection is visible to the user, who is nil if not signed in.
+func isCollectionVisible(collection *store.Collection, user *store.User) bool {
+	if user != nil && collection.CreatorID == user.ID {
+		return true
+	}
+	if collection.Visibility == store.Public {
+		return true
+	}
+	return user != nil && collection.Visibility == store.Protected
+}
+
+func convertCollectionFromStore(collection *store.Collection) *v1pb.Collection {
+	return &v1pb.Collection{
+		Name:        fmt.Sprintf("%s%d", CollectionNamePrefix, collection.ID),
+		Creator:     fmt.Sprintf("%s%d", UserNamePrefix, collection.CreatorID),
+		Title:       collection.Title,
+		Description: collection.Description,
+		Visibility:  convertVisibilityFromStore(collection.Visibility),
+		CreateTime:  timestamppb.New(time.Unix(collection.CreatedTs, 0)),
+		UpdateTime:  timestamppb.New(time.Unix(collection.UpdatedTs, 0)),
+	}
+}
@@ -32,6 +32,11 @@ func convertRowStatusToStore(rowStatus v1pb.RowStatus) store.RowStatus {
 	}
 }
 
+const (
+	// PageTokenVersionCursor is the version of the keyset based page tokens.
+	PageTokenVersionCursor = 1
+)
+
 func getPageToken(limit int, offset int) (string, error) {
 	return marshalPageToken(&v1pb.PageToken{
 		Limit:  int32(limit),
@@ -39,6 +44,34 @@ func getPageToken(limit int, offset int) (string, error) {
 	})
 }
 
+// getCursorPageToken returns a keyset page token that continues after the given cursor.
+func getCursorPageToken(limit int, cursor *v1pb.PageCursor) (string, error) {
+	return marshalPageToken(&v1pb.PageToken{
+		Limit:   int32(limit),
+		Version: PageTokenVersionCursor,
+		Cursor:  cursor,
+	})
+}
+
+// parsePageToken returns the page size and the position of the page to list.
+// Offset based tokens of older versions are still accepted, in which case the cursor is nil.
+func parsePageToken(pageSize int32, pageToken string) (limit int, offset int, cursor *v1pb.PageCursor, err error) {
+	if pageToken == "" {
+		return int(pageSize), 0, nil, nil
+	}
+	token := &v1pb.PageToken{}
+	if err := unmarshalPageToken(pageToken, token); err != nil {
+		return 0, 0, nil, err
+	}
+	if token.Version >= PageTokenVersionCursor {
+		if token.Cursor == nil {
+			return 0, 0, nil, errors.New("missing page cursor")
+		}
+		return int(token.Limit), 0, token.Cursor, nil
+	}
+	return int(token.Limit), int(token.Offset), nil, nil
+}
+
 func marshalPageToken(pageToken *v1pb.PageToken) (string, error) {
 	b, err := proto.Marshal(pageToken)
 	if err != nil {
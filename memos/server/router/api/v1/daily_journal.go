@@ -0,0 +1,165 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// dailyJournalTimeLayout is the layout of the local time to create the journal memos at.
+const dailyJournalTimeLayout = "15:04"
+
+// CreateDueDailyJournals creates the journal memos of the users whose journal time of the day has come.
+// A journal memo is created at most once per local day of the user.
+func (s *APIV1Service) CreateDueDailyJournals(ctx context.Context, now time.Time) error {
+	userSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{
+		Key: storepb.UserSettingKey_DAILY_JOURNAL,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list daily journal settings")
+	}
+	for _, userSetting := range userSettings {
+		dailyJournal := userSetting.GetDailyJournal()
+		if !dailyJournal.GetEnabled() {
+			continue
+		}
+		if err := s.createDueDailyJournal(ctx, userSetting.UserId, dailyJournal, now); err != nil {
+			slog.Warn("Failed to create daily journal", slog.Int("user", int(userSetting.UserId)), slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+func (s *APIV1Service) createDueDailyJournal(ctx context.Context, userID int32, dailyJournal *storepb.DailyJournalUserSetting, now time.Time) error {
+	location, err := time.LoadLocation(dailyJournal.Timezone)
+	if err != nil {
+		return errors.Wrap(err, "invalid timezone")
+	}
+	journalTime, err := time.Parse(dailyJournalTimeLayout, dailyJournal.Time)
+	if err != nil {
+		return errors.Wrap(err, "invalid time")
+	}
+	localNow := now.In(location)
+	today := localNow.Format(time.DateOnly)
+	if dailyJournal.LastDate == today {
+		return nil
+	}
+	if localNow.Hour()*60+localNow.Minute() < journalTime.Hour()*60+journalTime.Minute() {
+		return nil
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus == store.Archived {
+		return nil
+	}
+
+	// The day is recorded first, so that a journal memo is never created twice a day even if a later step fails.
+	previousDate, previousMemoID := dailyJournal.LastDate, dailyJournal.LastMemoId
+	dailyJournal.LastDate, dailyJournal.LastMemoId = today, 0
+	if err := s.upsertDailyJournalSetting(ctx, userID, dailyJournal); err != nil {
+		return err
+	}
+	memoMessage, err := s.createMemoOnBehalf(ctx, user, dailyJournal.TemplateId, "", v1pb.Visibility_VISIBILITY_UNSPECIFIED, localNow, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to create journal memo")
+	}
+	memoID, err := ExtractMemoIDFromName(memoMessage.Name)
+	if err != nil {
+		return err
+	}
+	dailyJournal.LastMemoId = memoID
+	if err := s.upsertDailyJournalSetting(ctx, userID, dailyJournal); err != nil {
+		return err
+	}
+
+	yesterday := localNow.AddDate(0, 0, -1).Format(time.DateOnly)
+	if previousDate == yesterday && previousMemoID != 0 {
+		previousMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID:             &previousMemoID,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to get previous journal memo")
+		}
+		if previousMemo != nil {
+			if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
+				MemoID:        memoID,
+				RelatedMemoID: previousMemo.ID,
+				Type:          store.MemoRelationReference,
+			}); err != nil {
+				return errors.Wrap(err, "failed to link previous journal memo")
+			}
+		}
+	}
+	return nil
+}
+
+func (s *APIV1Service) upsertDailyJournalSetting(ctx context.Context, userID int32, dailyJournal *storepb.DailyJournalUserSetting) error {
+	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_DAILY_JOURNAL,
+		Value:  &storepb.UserSetting_DailyJournal{DailyJournal: dailyJournal},
+	}); err != nil {
+		return errors.Wrap(err, "failed to update daily journal setting")
+	}
+	return nil
+}
+
+// convertDailyJournalSettingToStore validates the daily journal setting, keeping the last journal memo of the previous setting.
+func (s *APIV1Service) convertDailyJournalSettingToStore(ctx context.Context, dailyJournal *v1pb.UserSetting_DailyJournal, previous *storepb.DailyJournalUserSetting) (*storepb.DailyJournalUserSetting, error) {
+	if dailyJournal == nil {
+		dailyJournal = &v1pb.UserSetting_DailyJournal{}
+	}
+	setting := &storepb.DailyJournalUserSetting{
+		Enabled:    dailyJournal.Enabled,
+		Time:       dailyJournal.Time,
+		Timezone:   dailyJournal.Timezone,
+		LastDate:   previous.GetLastDate(),
+		LastMemoId: previous.GetLastMemoId(),
+	}
+	if setting.Timezone == "" {
+		setting.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(setting.Timezone); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid timezone %q", setting.Timezone)
+	}
+	if !dailyJournal.Enabled && dailyJournal.Template == "" {
+		return setting, nil
+	}
+	if _, err := time.Parse(dailyJournalTimeLayout, setting.Time); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid time %q, must be in the format of HH:MM", setting.Time)
+	}
+	template, err := s.getAvailableMemoTemplate(ctx, dailyJournal.Template)
+	if err != nil {
+		return nil, err
+	}
+	setting.TemplateId = template.ID
+	return setting, nil
+}
+
+func convertDailyJournalSettingFromStore(dailyJournal *storepb.DailyJournalUserSetting) *v1pb.UserSetting_DailyJournal {
+	message := &v1pb.UserSetting_DailyJournal{
+		Enabled:  dailyJournal.Enabled,
+		Time:     dailyJournal.Time,
+		Timezone: dailyJournal.Timezone,
+	}
+	if dailyJournal.TemplateId != 0 {
+		message.Template = fmt.Sprintf("%s%d", MemoTemplateNamePrefix, dailyJournal.TemplateId)
+	}
+	if dailyJournal.LastMemoId != 0 {
+		message.LastMemo = fmt.Sprintf("%s%d", MemoNamePrefix, dailyJournal.LastMemoId)
+	}
+	return message
+}
@@ -0,0 +1,511 @@
+package v1
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// MemoArchiveVersion is the version of the memo archive format.
+	// It is increased on every change of the manifest that is not backward compatible.
+	MemoArchiveVersion = 1
+	// MemoArchiveManifestPath is the path of the JSON manifest in the memo archive.
+	MemoArchiveManifestPath = "manifest.json"
+
+	memoArchiveChunkSize = 256 * 1024
+	// memoArchivePageSize is the number of memos or resources read at a time.
+	memoArchivePageSize = 100
+)
+
+// MemoArchiveManifest describes the memos of a user along with everything attached to them.
+// The binary content of resources is stored next to the manifest at the path of each resource.
+// It is written field by field in the order of the struct, see writeMemoArchiveManifest.
+type MemoArchiveManifest struct {
+	Version    int                         `json:"version"`
+	CreateTime time.Time                   `json:"createTime"`
+	Creator    *MemoArchiveUser            `json:"creator"`
+	Memos      []*MemoArchiveMemo          `json:"memos"`
+	Resources  []*MemoArchiveResource      `json:"resources"`
+	Relations  []*MemoArchiveRelation      `json:"relations"`
+	Reactions  []*MemoArchiveReaction      `json:"reactions"`
+	Organizers []*MemoArchiveMemoOrganizer `json:"organizers"`
+}
+
+type MemoArchiveUser struct {
+	ID       int32  `json:"id"`
+	Username string `json:"username"`
+}
+
+type MemoArchiveMemo struct {
+	ID         int32           `json:"id"`
+	UID        string          `json:"uid"`
+	RowStatus  string          `json:"rowStatus"`
+	CreatedTs  int64           `json:"createdTs"`
+	UpdatedTs  int64           `json:"updatedTs"`
+	DeletedTs  int64           `json:"deletedTs,omitempty"`
+	Content    string          `json:"content"`
+	Visibility string          `json:"visibility"`
+	Payload    json.RawMessage `json:"payload,omitempty"`
+}
+
+type MemoArchiveResource struct {
+	ID        int32  `json:"id"`
+	UID       string `json:"uid"`
+	CreatedTs int64  `json:"createdTs"`
+	UpdatedTs int64  `json:"updatedTs"`
+	DeletedTs int64  `json:"deletedTs,omitempty"`
+	Filename  string `json:"filename"`
+	Type      string `json:"type"`
+	Size      int64  `json:"size"`
+	MemoID    *int32 `json:"memoId,omitempty"`
+	// Path is the path of the binary content in the archive.
+	Path string `json:"path,omitempty"`
+	// StorageType and Reference locate the resources that are stored externally,
+	// whose binary content is not included in the archive.
+	StorageType string `json:"storageType,omitempty"`
+	Reference   string `json:"reference,omitempty"`
+}
+
+type MemoArchiveRelation struct {
+	MemoID        int32  `json:"memoId"`
+	RelatedMemoID int32  `json:"relatedMemoId"`
+	Type          string `json:"type"`
+}
+
+type MemoArchiveReaction struct {
+	ID           int32  `json:"id"`
+	CreatedTs    int64  `json:"createdTs"`
+	CreatorID    int32  `json:"creatorId"`
+	ContentID    string `json:"contentId"`
+	ReactionType string `json:"reactionType"`
+}
+
+type MemoArchiveMemoOrganizer struct {
+	MemoID int32 `json:"memoId"`
+	Pinned bool  `json:"pinned"`
+}
+
+func (s *APIV1Service) ExportMemoArchive(_ *v1pb.ExportMemoArchiveRequest, stream v1pb.MemoService_ExportMemoArchiveServer) error {
+	ctx := stream.Context()
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	writer := bufio.NewWriterSize(&httpBodyStreamWriter{stream: stream, contentType: "application/zip"}, memoArchiveChunkSize)
+	if err := WriteMemoArchive(ctx, s.Store, user, writer); err != nil {
+		return status.Errorf(codes.Internal, "failed to export memo archive: %v", err)
+	}
+	if err := writer.Flush(); err != nil {
+		return status.Errorf(codes.Internal, "failed to send memo archive: %v", err)
+	}
+	return nil
+}
+
+// httpBodyStreamWriter sends the written data as chunks of the stream.
+type httpBodyStreamWriter struct {
+	stream      v1pb.MemoService_ExportMemoArchiveServer
+	contentType string
+}
+
+func (w *httpBodyStreamWriter) Write(p []byte) (int, error) {
+	if err := w.stream.Send(&httpbody.HttpBody{
+		ContentType: w.contentType,
+		Data:        p,
+	}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// WriteMemoArchive writes a zip archive of the memos of the user to w. The memos and resources are
+// read page by page and written as they are read, so the archive is never held in memory as a whole.
+func WriteMemoArchive(ctx context.Context, s *store.Store, user *store.User, w io.Writer) error {
+	zipWriter := zip.NewWriter(w)
+	manifestWriter, err := zipWriter.Create(MemoArchiveManifestPath)
+	if err != nil {
+		return errors.Wrap(err, "failed to create manifest")
+	}
+	if err := writeMemoArchiveManifest(ctx, s, user, manifestWriter); err != nil {
+		return err
+	}
+
+	if err := forEachMemoArchiveResourcePage(ctx, s, user, func(resources []*store.Resource) error {
+		for _, resource := range resources {
+			archiveResource := convertResourceToMemoArchive(resource)
+			if archiveResource.Path == "" {
+				continue
+			}
+			header := &zip.FileHeader{
+				Name:   archiveResource.Path,
+				Method: zip.Deflate,
+			}
+			header.Modified = time.Unix(resource.UpdatedTs, 0)
+			fileWriter, err := zipWriter.CreateHeader(header)
+			if err != nil {
+				return errors.Wrapf(err, "failed to create file %s", archiveResource.Path)
+			}
+			if err := copyResourceBlob(ctx, s, resource, fileWriter); err != nil {
+				return errors.Wrapf(err, "failed to write resource %s", resource.UID)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return zipWriter.Close()
+}
+
+// writeMemoArchiveManifest writes the manifest to w, one page of memos or resources at a time.
+// The memos are read once for each list of the manifest, as the lists are written one after another.
+func writeMemoArchiveManifest(ctx context.Context, s *store.Store, user *store.User, w io.Writer) error {
+	encoder := &manifestEncoder{w: w}
+	encoder.field("version", MemoArchiveVersion)
+	encoder.field("createTime", time.Now().UTC())
+	encoder.field("creator", &MemoArchiveUser{
+		ID:       user.ID,
+		Username: user.Username,
+	})
+
+	encoder.beginList("memos")
+	if err := forEachMemoArchiveMemoPage(ctx, s, user, false, func(memos []*store.Memo) error {
+		for _, memo := range memos {
+			archiveMemo := &MemoArchiveMemo{
+				ID:         memo.ID,
+				UID:        memo.UID,
+				RowStatus:  string(memo.RowStatus),
+				CreatedTs:  memo.CreatedTs,
+				UpdatedTs:  memo.UpdatedTs,
+				DeletedTs:  memo.DeletedTs,
+				Content:    memo.Content,
+				Visibility: string(memo.Visibility),
+			}
+			if memo.Payload != nil {
+				payload, err := protojson.Marshal(memo.Payload)
+				if err != nil {
+					return errors.Wrap(err, "failed to marshal memo payload")
+				}
+				archiveMemo.Payload = payload
+			}
+			encoder.item(archiveMemo)
+		}
+		return encoder.err
+	}); err != nil {
+		return err
+	}
+	encoder.endList()
+
+	encoder.beginList("resources")
+	if err := forEachMemoArchiveResourcePage(ctx, s, user, func(resources []*store.Resource) error {
+		for _, resource := range resources {
+			encoder.item(convertResourceToMemoArchive(resource))
+		}
+		return encoder.err
+	}); err != nil {
+		return err
+	}
+	encoder.endList()
+
+	encoder.beginList("relations")
+	if err := forEachMemoArchiveMemoPage(ctx, s, user, true, func(memos []*store.Memo) error {
+		relations, err := listMemoArchiveRelations(ctx, s, user, memos)
+		if err != nil {
+			return err
+		}
+		for _, relation := range relations {
+			encoder.item(&MemoArchiveRelation{
+				MemoID:        relation.MemoID,
+				RelatedMemoID: relation.RelatedMemoID,
+				Type:          string(relation.Type),
+			})
+		}
+		return encoder.err
+	}); err != nil {
+		return err
+	}
+	encoder.endList()
+
+	encoder.beginList("reactions")
+	if err := forEachMemoArchiveMemoPage(ctx, s, user, true, func(memos []*store.Memo) error {
+		contentIDs := []string{}
+		for _, memo := range memos {
+			contentIDs = append(contentIDs, fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID))
+		}
+		reactions, err := s.ListReactions(ctx, &store.FindReaction{
+			ContentIDList: contentIDs,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list reactions")
+		}
+		for _, reaction := range reactions {
+			encoder.item(&MemoArchiveReaction{
+				ID:           reaction.ID,
+				CreatedTs:    reaction.CreatedTs,
+				CreatorID:    reaction.CreatorID,
+				ContentID:    reaction.ContentID,
+				ReactionType: reaction.ReactionType.String(),
+			})
+		}
+		return encoder.err
+	}); err != nil {
+		return err
+	}
+	encoder.endList()
+
+	encoder.beginList("organizers")
+	memoOrganizers, err := s.ListMemoOrganizer(ctx, &store.FindMemoOrganizer{
+		UserID: user.ID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo organizers")
+	}
+	for _, memoOrganizer := range memoOrganizers {
+		encoder.item(&MemoArchiveMemoOrganizer{
+			MemoID: memoOrganizer.MemoID,
+			Pinned: memoOrganizer.Pinned,
+		})
+	}
+	encoder.endList()
+	return encoder.close()
+}
+
+// listMemoArchiveRelations returns the relations of the memos in either direction. The relations from
+// other memos of the user are left out, as they are listed along with the memos they are from.
+func listMemoArchiveRelations(ctx context.Context, s *store.Store, user *store.User, memos []*store.Memo) ([]*store.MemoRelation, error) {
+	memoIDs := []int32{}
+	for _, memo := range memos {
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	relations, err := s.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoIDList: memoIDs,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo relations")
+	}
+	reverseRelations, err := s.ListMemoRelations(ctx, &store.FindMemoRelation{
+		RelatedMemoIDList: memoIDs,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo relations")
+	}
+	if len(reverseRelations) == 0 {
+		return relations, nil
+	}
+
+	relatedMemoIDs := []int32{}
+	for _, relation := range reverseRelations {
+		relatedMemoIDs = append(relatedMemoIDs, relation.MemoID)
+	}
+	userMemos, err := s.ListMemos(ctx, &store.FindMemo{
+		IDList:         relatedMemoIDs,
+		CreatorID:      &user.ID,
+		IncludeTrashed: true,
+		ExcludeContent: true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+	userMemoIDs := map[int32]bool{}
+	for _, memo := range userMemos {
+		userMemoIDs[memo.ID] = true
+	}
+	for _, relation := range reverseRelations {
+		if !userMemoIDs[relation.MemoID] {
+			relations = append(relations, relation)
+		}
+	}
+	return relations, nil
+}
+
+// forEachMemoArchiveMemoPage calls fn with the memos of the user, including the ones in the trash bin, page by page.
+func forEachMemoArchiveMemoPage(ctx context.Context, s *store.Store, user *store.User, excludeContent bool, fn func([]*store.Memo) error) error {
+	limit := memoArchivePageSize
+	memoFind := &store.FindMemo{
+		CreatorID:      &user.ID,
+		IncludeTrashed: true,
+		ExcludeContent: excludeContent,
+		Limit:          &limit,
+	}
+	for {
+		memos, err := s.ListMemos(ctx, memoFind)
+		if err != nil {
+			return errors.Wrap(err, "failed to list memos")
+		}
+		if len(memos) == 0 {
+			return nil
+		}
+		if err := fn(memos); err != nil {
+			return err
+		}
+		if len(memos) < limit {
+			return nil
+		}
+		lastMemo := memos[len(memos)-1]
+		memoFind.Cursor = &store.MemoCursor{
+			DisplayTs: lastMemo.CreatedTs,
+			ID:        lastMemo.ID,
+		}
+	}
+}
+
+// forEachMemoArchiveResourcePage calls fn with the resources of the user, including the ones in the trash bin, page by page.
+func forEachMemoArchiveResourcePage(ctx context.Context, s *store.Store, user *store.User, fn func([]*store.Resource) error) error {
+	limit, offset := memoArchivePageSize, 0
+	for {
+		resources, err := s.ListResources(ctx, &store.FindResource{
+			CreatorID:      &user.ID,
+			IncludeTrashed: true,
+			Limit:          &limit,
+			Offset:         &offset,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list resources")
+		}
+		if len(resources) == 0 {
+			return nil
+		}
+		if err := fn(resources); err != nil {
+			return err
+		}
+		if len(resources) < limit {
+			return nil
+		}
+		offset += limit
+	}
+}
+
+func convertResourceToMemoArchive(resource *store.Resource) *MemoArchiveResource {
+	archiveResource := &MemoArchiveResource{
+		ID:        resource.ID,
+		UID:       resource.UID,
+		CreatedTs: resource.CreatedTs,
+		UpdatedTs: resource.UpdatedTs,
+		DeletedTs: resource.DeletedTs,
+		Filename:  resource.Filename,
+		Type:      resource.Type,
+		Size:      resource.Size,
+		MemoID:    resource.MemoID,
+	}
+	// The payload is left out as it holds the storage credentials.
+	if resource.StorageType == storepb.ResourceStorageType_LOCAL || resource.StorageType == storepb.ResourceStorageType_RESOURCE_STORAGE_TYPE_UNSPECIFIED {
+		archiveResource.Path = path.Join("resources", resource.UID, path.Base(filepath.ToSlash(resource.Filename)))
+	} else {
+		archiveResource.StorageType = resource.StorageType.String()
+		archiveResource.Reference = resource.Reference
+	}
+	return archiveResource
+}
+
+// manifestEncoder writes a JSON object field by field, so that its lists can be written item by item.
+// The first error is kept and returned by close.
+type manifestEncoder struct {
+	w          io.Writer
+	err        error
+	fieldCount int
+	itemCount  int
+}
+
+func (e *manifestEncoder) write(data []byte) {
+	if e.err == nil {
+		_, e.err = e.w.Write(data)
+	}
+}
+
+func (e *manifestEncoder) writeValue(value any) {
+	data, err := json.Marshal(value)
+	if err != nil && e.err == nil {
+		e.err = err
+	}
+	e.write(data)
+}
+
+func (e *manifestEncoder) writeName(name string) {
+	if e.fieldCount == 0 {
+		e.write([]byte("{"))
+	} else {
+		e.write([]byte(","))
+	}
+	e.fieldCount++
+	e.writeValue(name)
+	e.write([]byte(":"))
+}
+
+func (e *manifestEncoder) field(name string, value any) {
+	e.writeName(name)
+	e.writeValue(value)
+}
+
+func (e *manifestEncoder) beginList(name string) {
+	e.writeName(name)
+	e.write([]byte("["))
+	e.itemCount = 0
+}
+
+func (e *manifestEncoder) item(value any) {
+	if e.itemCount != 0 {
+		e.write([]byte(","))
+	}
+	e.itemCount++
+	e.writeValue(value)
+}
+
+func (e *manifestEncoder) endList() {
+	e.write([]byte("]"))
+}
+
+func (e *manifestEncoder) close() error {
+	e.write([]byte("}\n"))
+	return e.err
+}
+
+// copyResourceBlob copies the binary content of a resource stored in the database or the local file system to w.
+func copyResourceBlob(ctx context.Context, s *store.Store, resource *store.Resource, w io.Writer) error {
+	if resource.StorageType == storepb.ResourceStorageType_LOCAL {
+		resourcePath := filepath.FromSlash(resource.Reference)
+		if !filepath.IsAbs(resourcePath) {
+			resourcePath = filepath.Join(s.Profile.Data, resourcePath)
+		}
+		file, err := os.Open(resourcePath)
+		if err != nil {
+			return errors.Wrap(err, "failed to open the file")
+		}
+		defer file.Close()
+		_, err = io.Copy(w, file)
+		return err
+	}
+
+	blobResource, err := s.GetResource(ctx, &store.FindResource{
+		ID:             &resource.ID,
+		GetBlob:        true,
+		IncludeTrashed: true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get resource blob")
+	}
+	if blobResource == nil {
+		return errors.New("resource not found")
+	}
+	_, err = w.Write(blobResource.Blob)
+	return err
+}
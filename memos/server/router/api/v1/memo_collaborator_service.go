@@ -0,0 +1,210 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// memoSharePermissionLevels orders the permissions of memo shares, each of which includes the lower ones.
+var memoSharePermissionLevels = map[store.MemoSharePermission]int{
+	store.MemoSharePermissionRead:    1,
+	store.MemoSharePermissionComment: 2,
+	store.MemoSharePermissionEdit:    3,
+}
+
+func (s *APIV1Service) SetMemoCollaborator(ctx context.Context, request *v1pb.SetMemoCollaboratorRequest) (*v1pb.MemoCollaborator, error) {
+	memo, err := s.getCurrentUserMemo(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+	if request.Collaborator == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "collaborator is required")
+	}
+	permission := convertMemoSharePermissionToStore(request.Collaborator.Permission)
+	if permission == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "permission is required")
+	}
+	userID, err := ExtractUserIDFromName(request.Collaborator.User)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	if userID == memo.CreatorID {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot share a memo with its creator")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	existing, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{
+		MemoID: &memo.ID,
+		UserID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+	}
+	var share *store.MemoShare
+	if err := s.Store.WithTx(ctx, func(txStore *store.Store) error {
+		share, err = txStore.UpsertMemoShare(ctx, &store.MemoShare{
+			MemoID:     memo.ID,
+			CreatorID:  memo.CreatorID,
+			UserID:     user.ID,
+			Permission: permission,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to upsert memo share")
+		}
+		// Notify the user when the memo is shared with them, or their permission changes.
+		if existing != nil && existing.Permission == permission {
+			return nil
+		}
+		return notifyMemoShare(ctx, txStore, share)
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to share memo: %v", err)
+	}
+	return convertMemoShareFromStore(share), nil
+}
+
+func (s *APIV1Service) ListMemoCollaborators(ctx context.Context, request *v1pb.ListMemoCollaboratorsRequest) (*v1pb.ListMemoCollaboratorsResponse, error) {
+	memo, err := s.getCurrentUserMemo(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.Store.ListMemoShares(ctx, &store.FindMemoShare{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo shares: %v", err)
+	}
+	response := &v1pb.ListMemoCollaboratorsResponse{
+		Collaborators: []*v1pb.MemoCollaborator{},
+	}
+	for _, share := range shares {
+		response.Collaborators = append(response.Collaborators, convertMemoShareFromStore(share))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RemoveMemoCollaborator(ctx context.Context, request *v1pb.RemoveMemoCollaboratorRequest) (*emptypb.Empty, error) {
+	memoID, userID, err := ExtractMemoCollaboratorFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo collaborator name: %v", err)
+	}
+	memo, err := s.getCurrentUserMemo(ctx, fmt.Sprintf("%s%d", MemoNamePrefix, memoID))
+	if err != nil {
+		return nil, err
+	}
+
+	share, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{
+		MemoID: &memo.ID,
+		UserID: &userID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+	}
+	if share == nil {
+		return nil, status.Errorf(codes.NotFound, "memo collaborator not found")
+	}
+	if err := s.Store.DeleteMemoShare(ctx, &store.DeleteMemoShare{
+		ID: &share.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memo share: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// hasMemoSharePermission returns whether the memo is shared with the user with at least the given permission.
+// Expired memos are not shared with anyone.
+func (s *APIV1Service) hasMemoSharePermission(ctx context.Context, memo *store.Memo, user *store.User, permission store.MemoSharePermission) (bool, error) {
+	if user == nil || isMemoExpired(memo, time.Now()) {
+		return false, nil
+	}
+	share, err := s.Store.GetMemoShare(ctx, &store.FindMemoShare{
+		MemoID: &memo.ID,
+		UserID: &user.ID,
+	})
+	if err != nil {
+		return false, err
+	}
+	return share != nil && memoSharePermissionLevels[share.Permission] >= memoSharePermissionLevels[permission], nil
+}
+
+// notifyMemoShare delivers the memo share to the inbox of the user it is shared with.
+func notifyMemoShare(ctx context.Context, s *store.Store, share *store.MemoShare) error {
+	activity, err := s.CreateActivity(ctx, &store.Activity{
+		CreatorID: share.CreatorID,
+		Type:      store.ActivityTypeMemoShare,
+		Level:     store.ActivityLevelInfo,
+		Payload: &storepb.ActivityPayload{
+			MemoShare: &storepb.ActivityMemoSharePayload{
+				MemoId:     share.MemoID,
+				Permission: share.Permission.String(),
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to create activity")
+	}
+	if _, err := s.CreateInbox(ctx, &store.Inbox{
+		SenderID:   share.CreatorID,
+		ReceiverID: share.UserID,
+		Status:     store.UNREAD,
+		Message: &storepb.InboxMessage{
+			Type:       storepb.InboxMessage_MEMO_SHARE,
+			ActivityId: &activity.ID,
+		},
+	}); err != nil {
+		return errors.Wrap(err, "failed to create inbox")
+	}
+	return nil
+}
+
+func convertMemoShareFromStore(share *store.MemoShare) *v1pb.MemoCollaborator {
+	return &v1pb.MemoCollaborator{
+		Name:       fmt.Sprintf("%s%d/%s%d", MemoNamePrefix, share.MemoID, MemoCollaboratorNamePrefix, share.UserID),
+		User:       fmt.Sprintf("%s%d", UserNamePrefix, share.UserID),
+		Permission: convertMemoSharePermissionFromStore(share.Permission),
+		CreateTime: timestamppb.New(time.Unix(share.CreatedTs, 0)),
+	}
+}
+
+func convertMemoSharePermissionFromStore(permission store.MemoSharePermission) v1pb.MemoCollaborator_Permission {
+	switch permission {
+	case store.MemoSharePermissionRead:
+		return v1pb.MemoCollaborator_READ
+	case store.MemoSharePermissionComment:
+		return v1pb.MemoCollaborator_COMMENT
+	case store.MemoSharePermissionEdit:
+		return v1pb.MemoCollaborator_EDIT
+	default:
+		return v1pb.MemoCollaborator_PERMISSION_UNSPECIFIED
+	}
+}
+
+func convertMemoSharePermissionToStore(permission v1pb.MemoCollaborator_Permission) store.MemoSharePermission {
+	switch permission {
+	case v1pb.MemoCollaborator_READ:
+		return store.MemoSharePermissionRead
+	case v1pb.MemoCollaborator_COMMENT:
+		return store.MemoSharePermissionComment
+	case v1pb.MemoCollaborator_EDIT:
+		return store.MemoSharePermissionEdit
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,224 @@
+package v1
+
+import (
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// customPropertyNameMatcher matches the names of custom properties, which are usable as CEL identifiers.
+var customPropertyNameMatcher = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,63}$`)
+
+// convertMemoCustomPropertiesToStore validates the custom properties and infers the types of the ones without.
+func convertMemoCustomPropertiesToStore(properties map[string]*v1pb.MemoCustomProperty) (map[string]*storepb.MemoPayload_CustomProperty, error) {
+	if len(properties) == 0 {
+		return nil, nil
+	}
+	customProperties := map[string]*storepb.MemoPayload_CustomProperty{}
+	for name, property := range properties {
+		customProperty, err := convertMemoCustomPropertyToStore(name, property)
+		if err != nil {
+			return nil, err
+		}
+		customProperties[name] = customProperty
+	}
+	return customProperties, nil
+}
+
+func convertMemoCustomPropertyToStore(name string, property *v1pb.MemoCustomProperty) (*storepb.MemoPayload_CustomProperty, error) {
+	if !customPropertyNameMatcher.MatchString(name) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid custom property name %q", name)
+	}
+	customProperty := &storepb.MemoPayload_CustomProperty{
+		Type:          storepb.MemoPayload_CustomProperty_Type(property.GetType()),
+		AllowedValues: property.AllowedValues,
+	}
+	switch value := property.GetValue().(type) {
+	case *v1pb.MemoCustomProperty_StringValue:
+		customProperty.Value = &storepb.MemoPayload_CustomProperty_StringValue{StringValue: value.StringValue}
+	case *v1pb.MemoCustomProperty_NumberValue:
+		customProperty.Value = &storepb.MemoPayload_CustomProperty_NumberValue{NumberValue: value.NumberValue}
+	case *v1pb.MemoCustomProperty_BoolValue:
+		customProperty.Value = &storepb.MemoPayload_CustomProperty_BoolValue{BoolValue: value.BoolValue}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "value of custom property %q is required", name)
+	}
+	if customProperty.Type == storepb.MemoPayload_CustomProperty_TYPE_UNSPECIFIED {
+		customProperty.Type = inferMemoCustomPropertyType(customProperty)
+	}
+	if err := validateMemoCustomProperty(customProperty); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid custom property %q: %v", name, err)
+	}
+	return customProperty, nil
+}
+
+// inferMemoCustomPropertyType returns the type of the value, where strings are STRING.
+func inferMemoCustomPropertyType(property *storepb.MemoPayload_CustomProperty) storepb.MemoPayload_CustomProperty_Type {
+	switch property.GetValue().(type) {
+	case *storepb.MemoPayload_CustomProperty_NumberValue:
+		return storepb.MemoPayload_CustomProperty_NUMBER
+	case *storepb.MemoPayload_CustomProperty_BoolValue:
+		return storepb.MemoPayload_CustomProperty_BOOL
+	default:
+		return storepb.MemoPayload_CustomProperty_STRING
+	}
+}
+
+// validateMemoCustomProperty checks the value matches the type of the property.
+func validateMemoCustomProperty(property *storepb.MemoPayload_CustomProperty) error {
+	if property.Type != storepb.MemoPayload_CustomProperty_ENUM && len(property.AllowedValues) > 0 {
+		return errors.New("allowed values are only for enum properties")
+	}
+	switch property.Type {
+	case storepb.MemoPayload_CustomProperty_STRING:
+		if _, ok := property.GetValue().(*storepb.MemoPayload_CustomProperty_StringValue); !ok {
+			return errors.New("string value is required")
+		}
+	case storepb.MemoPayload_CustomProperty_DATE:
+		if _, err := time.Parse(time.DateOnly, property.GetStringValue()); err != nil {
+			return errors.New("date value must be formatted as YYYY-MM-DD")
+		}
+	case storepb.MemoPayload_CustomProperty_ENUM:
+		if len(property.AllowedValues) == 0 {
+			return errors.New("allowed values are required")
+		}
+		if _, ok := property.GetValue().(*storepb.MemoPayload_CustomProperty_StringValue); !ok || !slices.Contains(property.AllowedValues, property.GetStringValue()) {
+			return errors.Errorf("value must be one of %q", property.AllowedValues)
+		}
+	case storepb.MemoPayload_CustomProperty_NUMBER:
+		value, ok := property.GetValue().(*storepb.MemoPayload_CustomProperty_NumberValue)
+		if !ok {
+			return errors.New("number value is required")
+		}
+		if math.IsNaN(value.NumberValue) || math.IsInf(value.NumberValue, 0) {
+			return errors.New("number value must be finite")
+		}
+	case storepb.MemoPayload_CustomProperty_BOOL:
+		if _, ok := property.GetValue().(*storepb.MemoPayload_CustomProperty_BoolValue); !ok {
+			return errors.New("bool value is required")
+		}
+	default:
+		return errors.Errorf("unsupported type %v", property.Type)
+	}
+	return nil
+}
+
+func convertMemoCustomPropertiesFromStore(customProperties map[string]*storepb.MemoPayload_CustomProperty) map[string]*v1pb.MemoCustomProperty {
+	if len(customProperties) == 0 {
+		return nil
+	}
+	properties := map[string]*v1pb.MemoCustomProperty{}
+	for name, customProperty := range customProperties {
+		property := &v1pb.MemoCustomProperty{
+			Type:          v1pb.MemoCustomProperty_Type(customProperty.Type),
+			AllowedValues: customProperty.AllowedValues,
+		}
+		switch value := customProperty.GetValue().(type) {
+		case *storepb.MemoPayload_CustomProperty_StringValue:
+			property.Value = &v1pb.MemoCustomProperty_StringValue{StringValue: value.StringValue}
+		case *storepb.MemoPayload_CustomProperty_NumberValue:
+			property.Value = &v1pb.MemoCustomProperty_NumberValue{NumberValue: value.NumberValue}
+		case *storepb.MemoPayload_CustomProperty_BoolValue:
+			property.Value = &v1pb.MemoCustomProperty_BoolValue{BoolValue: value.BoolValue}
+		}
+		properties[name] = property
+	}
+	return properties
+}
+
+// mergeMemoCustomPropertiesFromContent sets the custom properties found in the front matter of the content,
+// and removes the ones extracted from the front matter before whose keys are no longer in it.
+// A string keeps the ENUM type of the property it replaces, so it must be one of the allowed values.
+func mergeMemoCustomPropertiesFromContent(customProperties map[string]*storepb.MemoPayload_CustomProperty, content string) (map[string]*storepb.MemoPayload_CustomProperty, error) {
+	frontMatterProperties := getMemoCustomPropertiesFromContent(content)
+	for name, property := range customProperties {
+		if _, ok := frontMatterProperties[name]; !ok && property.FromFrontMatter {
+			delete(customProperties, name)
+		}
+	}
+	if len(frontMatterProperties) == 0 {
+		return customProperties, nil
+	}
+	if customProperties == nil {
+		customProperties = map[string]*storepb.MemoPayload_CustomProperty{}
+	}
+	for name, property := range frontMatterProperties {
+		if existing, ok := customProperties[name]; ok && existing.Type == storepb.MemoPayload_CustomProperty_ENUM &&
+			property.Type == storepb.MemoPayload_CustomProperty_STRING {
+			property.Type, property.AllowedValues = storepb.MemoPayload_CustomProperty_ENUM, existing.AllowedValues
+			if err := validateMemoCustomProperty(property); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid custom property %q: %v", name, err)
+			}
+		}
+		property.FromFrontMatter = true
+		customProperties[name] = property
+	}
+	return customProperties, nil
+}
+
+// getMemoCustomPropertiesFromContent returns the scalar fields of the YAML front matter of the content as custom properties.
+// Content whose front matter is not a valid YAML mapping has no properties, as `---` may be a thematic break.
+func getMemoCustomPropertiesFromContent(content string) map[string]*storepb.MemoPayload_CustomProperty {
+	frontMatter, _, ok := cutFrontMatter(content)
+	if !ok {
+		return nil
+	}
+	document := &yaml.Node{}
+	if err := yaml.Unmarshal([]byte(frontMatter), document); err != nil || len(document.Content) == 0 {
+		return nil
+	}
+	mapping := document.Content[0]
+	if mapping.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	customProperties := map[string]*storepb.MemoPayload_CustomProperty{}
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		name, node := mapping.Content[i].Value, mapping.Content[i+1]
+		if !customPropertyNameMatcher.MatchString(name) || node.Kind != yaml.ScalarNode {
+			continue
+		}
+		property := &storepb.MemoPayload_CustomProperty{}
+		switch node.ShortTag() {
+		case "!!str":
+			property.Type = storepb.MemoPayload_CustomProperty_STRING
+			property.Value = &storepb.MemoPayload_CustomProperty_StringValue{StringValue: node.Value}
+		case "!!int", "!!float":
+			value, err := strconv.ParseFloat(strings.ReplaceAll(node.Value, "_", ""), 64)
+			if err != nil || math.IsNaN(value) || math.IsInf(value, 0) {
+				continue
+			}
+			property.Type = storepb.MemoPayload_CustomProperty_NUMBER
+			property.Value = &storepb.MemoPayload_CustomProperty_NumberValue{NumberValue: value}
+		case "!!bool":
+			value := false
+			if err := node.Decode(&value); err != nil {
+				continue
+			}
+			property.Type = storepb.MemoPayload_CustomProperty_BOOL
+			property.Value = &storepb.MemoPayload_CustomProperty_BoolValue{BoolValue: value}
+		case "!!timestamp":
+			// Only dates are DATE properties, other timestamps are kept as they are written.
+			property.Type = storepb.MemoPayload_CustomProperty_STRING
+			if _, err := time.Parse(time.DateOnly, node.Value); err == nil {
+				property.Type = storepb.MemoPayload_CustomProperty_DATE
+			}
+			property.Value = &storepb.MemoPayload_CustomProperty_StringValue{StringValue: node.Value}
+		default:
+			continue
+		}
+		customProperties[name] = property
+	}
+	return customProperties
+}
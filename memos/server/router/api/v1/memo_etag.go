@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/usememos/memos/store"
+)
+
+// getMemoEtag returns the version of the memo, derived from its update time and a hash of all its mutable fields.
+func getMemoEtag(memo *store.Memo) string {
+	// The payload is read from the store, so marshaling it back never fails.
+	payload, _ := proto.MarshalOptions{Deterministic: true}.Marshal(memo.Payload)
+	hash := sha256.New()
+	fmt.Fprintf(hash, "%s\x00%s\x00%s\x00%d\x00%d\x00%s\x00", memo.UID, memo.RowStatus, memo.Visibility, memo.CreatedTs, memo.UpdatedTs, memo.Content)
+	hash.Write(payload)
+	return fmt.Sprintf("%d-%s", memo.UpdatedTs, hex.EncodeToString(hash.Sum(nil)[:8]))
+}
+
+// newMemoUpdate returns the update of the memo that only applies if the memo has not been changed since it was read.
+// The update time always moves forward, so that the etag of the updated memo differs from the one it is checked against.
+func newMemoUpdate(memo *store.Memo, now time.Time) *store.UpdateMemo {
+	updatedTs, expectedUpdatedTs := max(now.Unix(), memo.UpdatedTs+1), memo.UpdatedTs
+	return &store.UpdateMemo{
+		ID:                memo.ID,
+		UpdatedTs:         &updatedTs,
+		ExpectedUpdatedTs: &expectedUpdatedTs,
+	}
+}
+
+// checkMemoEtag returns a FailedPrecondition error carrying the current memo if the etag is stale.
+// An empty etag always matches.
+func (s *APIV1Service) checkMemoEtag(ctx context.Context, memo *store.Memo, etag string) error {
+	if etag == "" || etag == getMemoEtag(memo) {
+		return nil
+	}
+	return s.newMemoChangedError(ctx, memo)
+}
+
+// handleMemoChanged turns the ErrMemoChanged of a conditional write of the memo into the same error as a stale etag.
+func (s *APIV1Service) handleMemoChanged(ctx context.Context, id int32, err error) error {
+	if !errors.Is(err, store.ErrMemoChanged) {
+		return err
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return status.Errorf(codes.NotFound, "memo not found")
+	}
+	return s.newMemoChangedError(ctx, memo)
+}
+
+func (s *APIV1Service) newMemoChangedError(ctx context.Context, memo *store.Memo) error {
+	memoMessage, err := s.convertMemoFromStore(ctx, memo)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to convert memo: %v", err)
+	}
+	st, err := status.New(codes.FailedPrecondition, "memo has been changed, etag mismatch").WithDetails(memoMessage)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to attach current memo: %v", err)
+	}
+	return st.Err()
+}
@@ -0,0 +1,117 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// ExpireMemos archives or deletes the memos that have expired at the given time, following their policies.
+func (s *APIV1Service) ExpireMemos(ctx context.Context, now time.Time) error {
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		Filter: &store.MemoFilterAnd{
+			Filters: []store.MemoFilter{
+				&store.MemoFilterCondition{Field: store.MemoFilterFieldExpireTs, Operator: store.MemoFilterOperatorGreater, Value: int64(0)},
+				&store.MemoFilterCondition{Field: store.MemoFilterFieldExpireTs, Operator: store.MemoFilterOperatorLessOrEqual, Value: now.Unix()},
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list expired memos")
+	}
+	for _, memo := range memos {
+		if err := s.expireMemo(ctx, memo, now); err != nil {
+			slog.Warn("Failed to expire memo", slog.Int("memo", int(memo.ID)), slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+func (s *APIV1Service) expireMemo(ctx context.Context, memo *store.Memo, now time.Time) error {
+	if memo.Payload.GetExpiration().GetPolicy() == storepb.MemoPayload_Expiration_DELETE {
+		if memoMessage, err := s.convertMemoFromStore(ctx, memo); err == nil {
+			if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
+				slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
+			}
+		}
+		return s.Store.PurgeMemo(ctx, memo.ID)
+	}
+
+	// The expiration is cleared along with archiving, so that restoring the memo keeps it.
+	archived := store.Archived
+	payload := memo.Payload
+	payload.Expiration = nil
+	// The memos changed meanwhile fail to archive here, and are archived with the next run if still expired.
+	update := newMemoUpdate(memo, now)
+	update.RowStatus, update.Payload = &archived, payload
+	if err := s.Store.UpdateMemo(ctx, update); err != nil {
+		return errors.Wrap(err, "failed to archive memo")
+	}
+	memo.RowStatus, memo.UpdatedTs = archived, *update.UpdatedTs
+	memoMessage, err := s.convertMemoFromStore(ctx, memo)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
+		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
+	}
+	return nil
+}
+
+// convertMemoExpirationToStore validates the expiration of a memo, which is nil if the expire time is unset.
+func convertMemoExpirationToStore(expireTime *timestamppb.Timestamp, policy v1pb.MemoExpirationPolicy) (*storepb.MemoPayload_Expiration, error) {
+	if expireTime == nil {
+		if policy != v1pb.MemoExpirationPolicy_MEMO_EXPIRATION_POLICY_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "expire time is required along with expiration policy")
+		}
+		return nil, nil
+	}
+	if expireTime.AsTime().Unix() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid expire time")
+	}
+	expiration := &storepb.MemoPayload_Expiration{
+		ExpireTs: expireTime.AsTime().Unix(),
+		Policy:   storepb.MemoPayload_Expiration_ARCHIVE,
+	}
+	if policy == v1pb.MemoExpirationPolicy_DELETE {
+		expiration.Policy = storepb.MemoPayload_Expiration_DELETE
+	}
+	return expiration, nil
+}
+
+func convertMemoExpirationPolicyFromStore(policy storepb.MemoPayload_Expiration_Policy) v1pb.MemoExpirationPolicy {
+	switch policy {
+	case storepb.MemoPayload_Expiration_ARCHIVE:
+		return v1pb.MemoExpirationPolicy_ARCHIVE
+	case storepb.MemoPayload_Expiration_DELETE:
+		return v1pb.MemoExpirationPolicy_DELETE
+	default:
+		return v1pb.MemoExpirationPolicy_MEMO_EXPIRATION_POLICY_UNSPECIFIED
+	}
+}
+
+// isMemoExpired returns whether the memo has expired at the given time, which it is hidden from then on.
+func isMemoExpired(memo *store.Memo, now time.Time) bool {
+	expireTs := memo.Payload.GetExpiration().GetExpireTs()
+	return expireTs > 0 && expireTs <= now.Unix()
+}
+
+// getUnexpiredMemoFilter returns the filter of the memos that have not expired at the given time.
+// Expired memos are hidden before the sweeper gets to them.
+func getUnexpiredMemoFilter(now time.Time) store.MemoFilter {
+	return &store.MemoFilterOr{
+		Filters: []store.MemoFilter{
+			&store.MemoFilterCondition{Field: store.MemoFilterFieldExpireTs, Operator: store.MemoFilterOperatorEqual, Value: int64(0)},
+			&store.MemoFilterCondition{Field: store.MemoFilterFieldExpireTs, Operator: store.MemoFilterOperatorGreater, Value: now.Unix()},
+		},
+	}
+}
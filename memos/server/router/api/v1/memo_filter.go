@@ -0,0 +1,451 @@
+package v1
+
+import (
+	"slices"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/overloads"
+	"github.com/pkg/errors"
+	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+
+	"github.com/usememos/memos/store"
+)
+
+// SearchMemosFilterCELAttributes are the CEL attributes.
+var SearchMemosFilterCELAttributes = []cel.EnvOption{
+	cel.Variable("content", cel.StringType),
+	cel.Variable("content_search", cel.ListType(cel.StringType)),
+	cel.Variable("query", cel.StringType),
+	cel.Variable("visibility", cel.StringType),
+	cel.Variable("visibilities", cel.ListType(cel.StringType)),
+	cel.Variable("tag", cel.StringType),
+	cel.Variable("order_by_pinned", cel.BoolType),
+	cel.Variable("display_time", cel.IntType),
+	cel.Variable("display_time_before", cel.IntType),
+	cel.Variable("display_time_after", cel.IntType),
+	cel.Variable("creator", cel.StringType),
+	cel.Variable("uid", cel.StringType),
+	cel.Variable("row_status", cel.StringType),
+	cel.Variable("random", cel.BoolType),
+	cel.Variable("limit", cel.IntType),
+	cel.Variable("include_comments", cel.BoolType),
+	cel.Variable("has_link", cel.BoolType),
+	cel.Variable("has_task_list", cel.BoolType),
+	cel.Variable("has_code", cel.BoolType),
+	cel.Variable("has_incomplete_tasks", cel.BoolType),
+	cel.Variable("collection", cel.StringType),
+	// property is the custom properties of memos, e.g. `property.status == "open"`.
+	cel.Variable("property", cel.MapType(cel.StringType, cel.DynType)),
+}
+
+// SearchMemosFilter is the result of parsing a memo filter expression.
+// Options only make sense for the whole query, so they can only be used in the top-level `&&` chain.
+// Everything else is translated to a driver-neutral filter tree.
+type SearchMemosFilter struct {
+	// Creator is set when the filter is restricted to a single creator.
+	Creator         *string
+	Query           *string
+	OrderByPinned   bool
+	Random          bool
+	Limit           *int
+	IncludeComments bool
+	// CollectionIDs are the collections referred to by the filter, whose visibility must be checked.
+	CollectionIDs []int32
+	Filter        store.MemoFilter
+}
+
+// searchMemosFilterOptions are the variables that are not filters but options of the query.
+var searchMemosFilterOptions = []string{"query", "order_by_pinned", "random", "limit", "include_comments"}
+
+// memoFilterBoolFields are the boolean variables that map to memo fields.
+var memoFilterBoolFields = map[string]store.MemoFilterField{
+	"has_link":             store.MemoFilterFieldHasLink,
+	"has_task_list":        store.MemoFilterFieldHasTaskList,
+	"has_code":             store.MemoFilterFieldHasCode,
+	"has_incomplete_tasks": store.MemoFilterFieldHasIncompleteTasks,
+}
+
+var memoFilterComparisonOperators = map[string]store.MemoFilterOperator{
+	operators.Equals:        store.MemoFilterOperatorEqual,
+	operators.NotEquals:     store.MemoFilterOperatorNotEqual,
+	operators.Less:          store.MemoFilterOperatorLess,
+	operators.LessEquals:    store.MemoFilterOperatorLessOrEqual,
+	operators.Greater:       store.MemoFilterOperatorGreater,
+	operators.GreaterEquals: store.MemoFilterOperatorGreaterOrEqual,
+}
+
+// memoFilterConverter translates a CEL expression to a memo filter tree.
+type memoFilterConverter struct {
+	// displayTimeField is the memo field that display time refers to.
+	displayTimeField store.MemoFilterField
+	// collectionIDs are the collections referred to so far.
+	collectionIDs []int32
+}
+
+func parseSearchMemosFilter(expression string, displayWithUpdateTime bool) (*SearchMemosFilter, error) {
+	e, err := cel.NewEnv(SearchMemosFilterCELAttributes...)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := e.Compile(expression)
+	if issues != nil {
+		return nil, errors.Errorf("found issue %v", issues)
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, errors.Errorf("filter must be a boolean expression")
+	}
+	parsedExpr, err := cel.AstToParsedExpr(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	converter := &memoFilterConverter{
+		displayTimeField: store.MemoFilterFieldCreatedTs,
+	}
+	if displayWithUpdateTime {
+		converter.displayTimeField = store.MemoFilterFieldUpdatedTs
+	}
+	filter := &SearchMemosFilter{}
+	and := &store.MemoFilterAnd{}
+	for _, conjunct := range flattenConjunction(parsedExpr.GetExpr()) {
+		ok, err := filter.setOption(conjunct)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			continue
+		}
+		// A single creator is kept aside as the visibility of memos depends on it.
+		if callExpr := conjunct.GetCallExpr(); filter.Creator == nil && callExpr != nil && callExpr.Function == operators.Equals &&
+			callExpr.Args[0].GetIdentExpr().GetName() == "creator" && callExpr.Args[1].GetConstExpr() != nil {
+			creator := callExpr.Args[1].GetConstExpr().GetStringValue()
+			filter.Creator = &creator
+			continue
+		}
+		memoFilter, err := converter.convert(conjunct)
+		if err != nil {
+			return nil, err
+		}
+		and.Filters = append(and.Filters, memoFilter)
+	}
+	if len(and.Filters) > 0 {
+		filter.Filter = and
+	}
+	filter.CollectionIDs = converter.collectionIDs
+	return filter, nil
+}
+
+// flattenConjunction returns the operands of a chain of `&&`.
+func flattenConjunction(e *expr.Expr) []*expr.Expr {
+	callExpr := e.GetCallExpr()
+	if callExpr == nil || callExpr.Function != operators.LogicalAnd {
+		return []*expr.Expr{e}
+	}
+	conjuncts := []*expr.Expr{}
+	for _, arg := range callExpr.Args {
+		conjuncts = append(conjuncts, flattenConjunction(arg)...)
+	}
+	return conjuncts
+}
+
+// setOption sets the option if the expression is an option, e.g. `order_by_pinned == true`.
+func (f *SearchMemosFilter) setOption(e *expr.Expr) (bool, error) {
+	name, value := "", (*expr.Constant)(nil)
+	if identExpr := e.GetIdentExpr(); identExpr != nil {
+		name, value = identExpr.Name, &expr.Constant{ConstantKind: &expr.Constant_BoolValue{BoolValue: true}}
+	} else if callExpr := e.GetCallExpr(); callExpr != nil && callExpr.Function == operators.Equals && len(callExpr.Args) == 2 {
+		name, value = callExpr.Args[0].GetIdentExpr().GetName(), callExpr.Args[1].GetConstExpr()
+	}
+	if !slices.Contains(searchMemosFilterOptions, name) {
+		return false, nil
+	}
+	if value == nil {
+		return false, errors.Errorf("%q must be compared with a constant", name)
+	}
+
+	switch name {
+	case "query":
+		query := value.GetStringValue()
+		f.Query = &query
+	case "order_by_pinned":
+		f.OrderByPinned = value.GetBoolValue()
+	case "random":
+		f.Random = value.GetBoolValue()
+	case "limit":
+		limit := int(value.GetInt64Value())
+		f.Limit = &limit
+	case "include_comments":
+		f.IncludeComments = value.GetBoolValue()
+	}
+	return true, nil
+}
+
+func (c *memoFilterConverter) convert(e *expr.Expr) (store.MemoFilter, error) {
+	// A custom property alone is the same as `property.name == true`.
+	if name, ok := getCustomPropertyName(e); ok {
+		return convertCustomPropertyCondition(name, store.MemoFilterOperatorEqual, true)
+	}
+	if identExpr := e.GetIdentExpr(); identExpr != nil {
+		field, ok := memoFilterBoolFields[identExpr.Name]
+		if !ok {
+			return nil, c.unsupportedVariableError(identExpr.Name)
+		}
+		return &store.MemoFilterCondition{Field: field, Operator: store.MemoFilterOperatorEqual, Value: true}, nil
+	}
+
+	callExpr := e.GetCallExpr()
+	if callExpr == nil {
+		return nil, errors.Errorf("unsupported expression %v", e)
+	}
+	switch callExpr.Function {
+	case operators.LogicalAnd, operators.LogicalOr:
+		filters := []store.MemoFilter{}
+		for _, arg := range callExpr.Args {
+			filter, err := c.convert(arg)
+			if err != nil {
+				return nil, err
+			}
+			filters = append(filters, filter)
+		}
+		if callExpr.Function == operators.LogicalAnd {
+			return &store.MemoFilterAnd{Filters: filters}, nil
+		}
+		return &store.MemoFilterOr{Filters: filters}, nil
+	case operators.LogicalNot:
+		filter, err := c.convert(callExpr.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		return &store.MemoFilterNot{Filter: filter}, nil
+	case operators.Equals, operators.NotEquals, operators.Less, operators.LessEquals, operators.Greater, operators.GreaterEquals:
+		return c.convertComparison(callExpr)
+	case operators.In:
+		return c.convertIn(callExpr)
+	case overloads.StartsWith, overloads.Contains:
+		return c.convertStringMatch(callExpr)
+	default:
+		return nil, errors.Errorf("unsupported function %q", callExpr.Function)
+	}
+}
+
+func (c *memoFilterConverter) convertComparison(callExpr *expr.Expr_Call) (store.MemoFilter, error) {
+	if name, ok := getCustomPropertyName(callExpr.Args[0]); ok {
+		value, err := getConstValue(callExpr.Args[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for property %q", name)
+		}
+		return convertCustomPropertyCondition(name, memoFilterComparisonOperators[callExpr.Function], value)
+	}
+	name := callExpr.Args[0].GetIdentExpr().GetName()
+	if name == "" {
+		return nil, errors.Errorf("the left side of a comparison must be a variable")
+	}
+	if slices.Contains(searchMemosFilterOptions, name) {
+		return nil, c.unsupportedVariableError(name)
+	}
+	operator := memoFilterComparisonOperators[callExpr.Function]
+	isEquality := operator == store.MemoFilterOperatorEqual || operator == store.MemoFilterOperatorNotEqual
+
+	// The list variables are only compared by equality with a list of constants.
+	if name == "content_search" || name == "visibilities" {
+		if operator != store.MemoFilterOperatorEqual {
+			return nil, errors.Errorf("%q only supports ==", name)
+		}
+		values, err := getConstListValues(callExpr.Args[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value for %q", name)
+		}
+		if name == "visibilities" {
+			return &store.MemoFilterCondition{Field: store.MemoFilterFieldVisibility, Operator: store.MemoFilterOperatorIn, Value: values}, nil
+		}
+		and := &store.MemoFilterAnd{}
+		for _, value := range values {
+			and.Filters = append(and.Filters, &store.MemoFilterCondition{Field: store.MemoFilterFieldContent, Operator: store.MemoFilterOperatorContains, Value: value})
+		}
+		return and, nil
+	}
+
+	value, err := getConstValue(callExpr.Args[1])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid value for %q", name)
+	}
+	switch name {
+	case "display_time", "display_time_before", "display_time_after":
+		// `display_time_before == X` and `display_time_after == X` are the legacy forms of `display_time < X` and `display_time > X`.
+		if name == "display_time_before" && operator == store.MemoFilterOperatorEqual {
+			operator = store.MemoFilterOperatorLess
+		} else if name == "display_time_after" && operator == store.MemoFilterOperatorEqual {
+			operator = store.MemoFilterOperatorGreater
+		}
+		return &store.MemoFilterCondition{Field: c.displayTimeField, Operator: operator, Value: value}, nil
+	}
+	if !isEquality {
+		return nil, errors.Errorf("%q only supports == and !=", name)
+	}
+	if field, ok := memoFilterBoolFields[name]; ok {
+		return &store.MemoFilterCondition{Field: field, Operator: operator, Value: value}, nil
+	}
+	condition, err := c.convertEquality(name, value)
+	if err != nil {
+		return nil, err
+	}
+	if operator == store.MemoFilterOperatorNotEqual {
+		return &store.MemoFilterNot{Filter: condition}, nil
+	}
+	return condition, nil
+}
+
+// convertEquality converts `name == value` of the string variables.
+func (c *memoFilterConverter) convertEquality(name string, value any) (*store.MemoFilterCondition, error) {
+	switch name {
+	case "content":
+		return &store.MemoFilterCondition{Field: store.MemoFilterFieldContent, Operator: store.MemoFilterOperatorEqual, Value: value}, nil
+	case "tag":
+		// A tag matches the memos with any tag in its subtree.
+		return &store.MemoFilterCondition{Field: store.MemoFilterFieldTag, Operator: store.MemoFilterOperatorSubtree, Value: value}, nil
+	case "visibility":
+		return &store.MemoFilterCondition{Field: store.MemoFilterFieldVisibility, Operator: store.MemoFilterOperatorEqual, Value: value}, nil
+	case "uid":
+		return &store.MemoFilterCondition{Field: store.MemoFilterFieldUID, Operator: store.MemoFilterOperatorEqual, Value: value}, nil
+	case "row_status":
+		return &store.MemoFilterCondition{Field: store.MemoFilterFieldRowStatus, Operator: store.MemoFilterOperatorEqual, Value: value}, nil
+	case "creator":
+		creator, _ := value.(string)
+		userID, err := ExtractUserIDFromName(creator)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid user name")
+		}
+		return &store.MemoFilterCondition{Field: store.MemoFilterFieldCreatorID, Operator: store.MemoFilterOperatorEqual, Value: userID}, nil
+	case "collection":
+		collection, _ := value.(string)
+		collectionID, err := ExtractCollectionIDFromName(collection)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid collection name")
+		}
+		c.collectionIDs = append(c.collectionIDs, collectionID)
+		return &store.MemoFilterCondition{Field: store.MemoFilterFieldCollectionID, Operator: store.MemoFilterOperatorEqual, Value: collectionID}, nil
+	default:
+		return nil, c.unsupportedVariableError(name)
+	}
+}
+
+func (c *memoFilterConverter) convertIn(callExpr *expr.Expr_Call) (store.MemoFilter, error) {
+	propertyName, isCustomProperty := getCustomPropertyName(callExpr.Args[0])
+	name := callExpr.Args[0].GetIdentExpr().GetName()
+	if isCustomProperty {
+		name = propertyName
+	} else if name == "" {
+		return nil, errors.Errorf("the left side of `in` must be a variable")
+	}
+	values, err := getConstListValues(callExpr.Args[1])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid value for %q", name)
+	}
+	or := &store.MemoFilterOr{}
+	for _, value := range values {
+		if isCustomProperty {
+			condition, err := convertCustomPropertyCondition(name, store.MemoFilterOperatorEqual, value)
+			if err != nil {
+				return nil, err
+			}
+			or.Filters = append(or.Filters, condition)
+			continue
+		}
+		condition, err := c.convertEquality(name, value)
+		if err != nil {
+			return nil, err
+		}
+		or.Filters = append(or.Filters, condition)
+	}
+	return or, nil
+}
+
+func (c *memoFilterConverter) convertStringMatch(callExpr *expr.Expr_Call) (store.MemoFilter, error) {
+	name := callExpr.GetTarget().GetIdentExpr().GetName()
+	fields := map[string]store.MemoFilterField{
+		"content": store.MemoFilterFieldContent,
+		"tag":     store.MemoFilterFieldTag,
+	}
+	field, ok := fields[name]
+	if !ok {
+		return nil, errors.Errorf("%q is only supported on content and tag", callExpr.Function)
+	}
+	value, err := getConstValue(callExpr.Args[0])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid value for %q", callExpr.Function)
+	}
+	operator := store.MemoFilterOperatorContains
+	if callExpr.Function == overloads.StartsWith {
+		operator = store.MemoFilterOperatorStartsWith
+	}
+	return &store.MemoFilterCondition{Field: field, Operator: operator, Value: value}, nil
+}
+
+// getCustomPropertyName returns the name of the custom property if the expression is `property.name`.
+func getCustomPropertyName(e *expr.Expr) (string, bool) {
+	selectExpr := e.GetSelectExpr()
+	if selectExpr == nil || selectExpr.TestOnly || selectExpr.GetOperand().GetIdentExpr().GetName() != "property" {
+		return "", false
+	}
+	return selectExpr.Field, true
+}
+
+// convertCustomPropertyCondition compares the custom property with a string, a number or a bool.
+// Numbers are compared as float64, and dates as strings formatted as YYYY-MM-DD.
+func convertCustomPropertyCondition(name string, operator store.MemoFilterOperator, value any) (*store.MemoFilterCondition, error) {
+	if !customPropertyNameMatcher.MatchString(name) {
+		return nil, errors.Errorf("invalid property name %q", name)
+	}
+	switch v := value.(type) {
+	case int64:
+		value = float64(v)
+	case bool:
+		if operator != store.MemoFilterOperatorEqual && operator != store.MemoFilterOperatorNotEqual {
+			return nil, errors.Errorf("property %q only supports == and != with bool values", name)
+		}
+	}
+	return &store.MemoFilterCondition{Field: store.MemoFilterFieldCustomProperty, Key: name, Operator: operator, Value: value}, nil
+}
+
+func (*memoFilterConverter) unsupportedVariableError(name string) error {
+	if slices.Contains(searchMemosFilterOptions, name) {
+		return errors.Errorf("%q can only be used in the top-level && expression", name)
+	}
+	return errors.Errorf("unsupported usage of %q", name)
+}
+
+func getConstValue(e *expr.Expr) (any, error) {
+	constExpr := e.GetConstExpr()
+	if constExpr == nil {
+		return nil, errors.Errorf("must be a constant")
+	}
+	switch v := constExpr.ConstantKind.(type) {
+	case *expr.Constant_StringValue:
+		return v.StringValue, nil
+	case *expr.Constant_Int64Value:
+		return v.Int64Value, nil
+	case *expr.Constant_DoubleValue:
+		return v.DoubleValue, nil
+	case *expr.Constant_BoolValue:
+		return v.BoolValue, nil
+	default:
+		return nil, errors.Errorf("unsupported constant %v", constExpr)
+	}
+}
+
+func getConstListValues(e *expr.Expr) ([]any, error) {
+	listExpr := e.GetListExpr()
+	if listExpr == nil {
+		return nil, errors.Errorf("must be a list of constants")
+	}
+	values := []any{}
+	for _, element := range listExpr.Elements {
+		value, err := getConstValue(element)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
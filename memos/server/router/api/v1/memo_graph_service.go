@@ -0,0 +1,166 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// DefaultMemoGraphDepth is the depth of the memo graph if not requested.
+	DefaultMemoGraphDepth = 1
+	// MaxMemoGraphDepth is the maximum depth of the memo graph.
+	MaxMemoGraphDepth = 5
+	// MaxMemoGraphRoots is the maximum number of root memos found by tag or filter.
+	MaxMemoGraphRoots = 100
+)
+
+func (s *APIV1Service) GetMemoGraph(ctx context.Context, request *v1pb.GetMemoGraphRequest) (*v1pb.MemoGraph, error) {
+	depth := int(request.Depth)
+	if depth <= 0 {
+		depth = DefaultMemoGraphDepth
+	}
+	if depth > MaxMemoGraphDepth {
+		return nil, status.Errorf(codes.InvalidArgument, "depth must be at most %d", MaxMemoGraphDepth)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+
+	rootMemoIDs, err := s.findMemoGraphRoots(ctx, request, user)
+	if err != nil {
+		return nil, err
+	}
+	graphFind := &store.FindMemoGraph{
+		RootMemoIDList: rootMemoIDs,
+		MaxDepth:       depth,
+		Filter:         getVisibleMemoFilter(user, time.Now()),
+	}
+	for _, relationType := range request.Types {
+		graphFind.TypeList = append(graphFind.TypeList, convertMemoRelationTypeToStore(relationType))
+	}
+	nodes, err := s.Store.ListMemoGraphNodes(ctx, graphFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo graph nodes: %v", err)
+	}
+
+	graph := &v1pb.MemoGraph{
+		Nodes: []*v1pb.MemoGraphNode{},
+		Edges: []*v1pb.MemoRelation{},
+	}
+	if len(nodes) == 0 {
+		return graph, nil
+	}
+	memoIDs := []int32{}
+	for _, node := range nodes {
+		memoIDs = append(memoIDs, node.MemoID)
+	}
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		IDList: memoIDs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
+	}
+	memoMessageByName := map[string]*v1pb.Memo{}
+	for _, memoMessage := range memoMessages {
+		memoMessageByName[memoMessage.Name] = memoMessage
+	}
+	for _, node := range nodes {
+		memoMessage, ok := memoMessageByName[fmt.Sprintf("%s%d", MemoNamePrefix, node.MemoID)]
+		if !ok {
+			continue
+		}
+		graph.Nodes = append(graph.Nodes, &v1pb.MemoGraphNode{
+			Memo:  memoMessage,
+			Depth: int32(node.Depth),
+		})
+	}
+
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoIDList:        memoIDs,
+		RelatedMemoIDList: memoIDs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+	}
+	for _, relation := range relations {
+		if len(graphFind.TypeList) != 0 && !slices.Contains(graphFind.TypeList, relation.Type) {
+			continue
+		}
+		graph.Edges = append(graph.Edges, convertMemoRelationFromStore(relation))
+	}
+	return graph, nil
+}
+
+// findMemoGraphRoots returns the ids of the root memos of the graph, which are visible to the user.
+func (s *APIV1Service) findMemoGraphRoots(ctx context.Context, request *v1pb.GetMemoGraphRequest, user *store.User) ([]int32, error) {
+	if request.Name != "" {
+		if request.Tag != "" || request.Filter != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "name can not be used along with tag or filter")
+		}
+		id, err := ExtractMemoIDFromName(request.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+		}
+		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		if memo == nil {
+			return nil, status.Errorf(codes.NotFound, "memo not found")
+		}
+		if !isMemoVisible(memo, user) {
+			shared, err := s.hasMemoSharePermission(ctx, memo, user, store.MemoSharePermissionRead)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+			}
+			if !shared {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+			}
+		}
+		return []int32{memo.ID}, nil
+	}
+
+	filter := request.Filter
+	if request.Tag != "" {
+		tagFilter := fmt.Sprintf("tag == %q", request.Tag)
+		if filter != "" {
+			filter = fmt.Sprintf("(%s) && %s", filter, tagFilter)
+		} else {
+			filter = tagFilter
+		}
+	}
+	if filter == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "one of name, tag and filter is required")
+	}
+	limit := MaxMemoGraphRoots
+	memoFind := &store.FindMemo{
+		ExcludeContent: true,
+		Limit:          &limit,
+	}
+	if err := s.buildMemoFindWithFilter(ctx, memoFind, filter); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to build find memos with filter: %v", err)
+	}
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	memoIDs := []int32{}
+	for _, memo := range memos {
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	return memoIDs, nil
+}
@@ -0,0 +1,428 @@
+package v1
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// importedMemo is a memo parsed from a Markdown file of an import archive.
+type importedMemo struct {
+	UID        string
+	CreatedTs  int64
+	Visibility store.Visibility
+	Pinned     bool
+	Content    string
+	// Resources are the paths of the files attached to the memo.
+	Resources []string
+}
+
+// memoFrontMatter is the YAML front matter of an imported Markdown file.
+type memoFrontMatter struct {
+	UID        string     `yaml:"uid"`
+	Created    *time.Time `yaml:"created"`
+	Visibility string     `yaml:"visibility"`
+	Tags       []string   `yaml:"tags"`
+	Pinned     bool       `yaml:"pinned"`
+	Resources  []string   `yaml:"resources"`
+}
+
+// markdownLinkPattern matches the destination of Markdown links and images.
+var markdownLinkPattern = regexp.MustCompile(`\[[^\]]*\]\(([^)\s]+)\)`)
+
+func (s *APIV1Service) ImportMemos(ctx context.Context, request *v1pb.ImportMemosRequest) (*v1pb.ImportMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(request.Content), int64(len(request.Content)))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid zip archive: %v", err)
+	}
+	response, err := ImportMemosFromFS(ctx, s.Store, user, reader, request.DryRun)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to import memos: %v", err)
+	}
+	return response, nil
+}
+
+// ImportMemosFromFS imports the Markdown files of fsys as memos of the user.
+// Memos whose uid already exists are skipped. When dryRun is true, nothing is written
+// and the response reports what would be imported.
+func ImportMemosFromFS(ctx context.Context, s *store.Store, user *store.User, fsys fs.FS, dryRun bool) (*v1pb.ImportMemosResponse, error) {
+	workspaceMemoRelatedSetting, err := s.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace memo related setting")
+	}
+	workspaceStorageSetting, err := s.GetWorkspaceStorageSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace storage setting")
+	}
+	uploadSizeLimit := int64(workspaceStorageSetting.UploadSizeLimitMb) * MebiByte
+	if uploadSizeLimit == 0 {
+		uploadSizeLimit = MaxUploadBufferSizeBytes
+	}
+
+	memoPaths := []string{}
+	if err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		// Skip hidden files and the metadata folders of archivers, e.g. __MACOSX.
+		if p != "." && (strings.HasPrefix(d.Name(), ".") || strings.HasPrefix(d.Name(), "__")) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if !d.IsDir() && isMarkdownFile(p) {
+			memoPaths = append(memoPaths, p)
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to walk archive")
+	}
+
+	response := &v1pb.ImportMemosResponse{}
+	importedMemos := []*store.Memo{}
+	uids := map[string]bool{}
+	for _, memoPath := range memoPaths {
+		result := &v1pb.ImportMemoResult{
+			Path: memoPath,
+		}
+		response.Results = append(response.Results, result)
+		skip := func(format string, args ...any) {
+			result.Action = v1pb.ImportMemoResult_SKIP
+			result.Message = fmt.Sprintf(format, args...)
+			response.SkippedCount++
+		}
+
+		memo, err := parseImportedMemo(fsys, memoPath)
+		if err != nil {
+			skip("%v", err)
+			continue
+		}
+		result.Uid = memo.UID
+		result.Resources = memo.Resources
+		if uids[memo.UID] {
+			skip("duplicate uid %s in archive", memo.UID)
+			continue
+		}
+		uids[memo.UID] = true
+		existingMemo, err := s.GetMemo(ctx, &store.FindMemo{UID: &memo.UID, IncludeTrashed: true})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo")
+		}
+		if existingMemo != nil {
+			skip("memo with uid %s already exists", memo.UID)
+			continue
+		}
+		if len(memo.Content) > int(workspaceMemoRelatedSetting.ContentLengthLimit) {
+			skip("content too long (max %d characters)", workspaceMemoRelatedSetting.ContentLengthLimit)
+			continue
+		}
+		if workspaceMemoRelatedSetting.DisallowPublicVisible && memo.Visibility == store.Public {
+			skip("disable public memos system setting is enabled")
+			continue
+		}
+		if resourcePath, ok := findOversizedFile(fsys, memo.Resources, uploadSizeLimit); ok {
+			skip("file size of %s exceeds the limit", resourcePath)
+			continue
+		}
+
+		result.Action = v1pb.ImportMemoResult_IMPORT
+		response.ImportedCount++
+		if dryRun {
+			continue
+		}
+		importedMemo, err := importMemo(ctx, s, user, fsys, memo)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to import %s", memoPath)
+		}
+		result.Memo = fmt.Sprintf("%s%d", MemoNamePrefix, importedMemo.ID)
+		importedMemos = append(importedMemos, importedMemo)
+	}
+
+	// The references are synced once all memos are imported, as memos may link to the ones after them in the archive.
+	for _, memo := range importedMemos {
+		if err := syncMemoReferences(ctx, s, memo, ""); err != nil {
+			return nil, errors.Wrap(err, "failed to sync memo references")
+		}
+	}
+	return response, nil
+}
+
+func importMemo(ctx context.Context, s *store.Store, user *store.User, fsys fs.FS, memo *importedMemo) (created *store.Memo, err error) {
+	property, err := getMemoPropertyFromContent(memo.Content)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo property")
+	}
+	// The blobs are saved ahead of the transaction as they may be uploaded to an external storage,
+	// so they are deleted if the memo is not created.
+	resources := []*store.Resource{}
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, resource := range resources {
+			if err := s.DeleteResourceFile(ctx, resource); err != nil {
+				slog.Warn("Failed to delete resource file", slog.Any("err", err))
+			}
+		}
+	}()
+	for _, resourcePath := range memo.Resources {
+		blob, err := fs.ReadFile(fsys, resourcePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", resourcePath)
+		}
+		create := &store.Resource{
+			UID:       shortuuid.New(),
+			CreatorID: user.ID,
+			Filename:  path.Base(resourcePath),
+			Type:      getImportedFileType(resourcePath, blob),
+			Size:      int64(len(blob)),
+			Blob:      blob,
+		}
+		if err := SaveResourceBlob(ctx, s, create); err != nil {
+			return nil, errors.Wrap(err, "failed to save resource blob")
+		}
+		resources = append(resources, create)
+	}
+
+	if err := s.WithTx(ctx, func(txStore *store.Store) error {
+		var err error
+		created, err = txStore.CreateMemo(ctx, &store.Memo{
+			UID:        memo.UID,
+			CreatorID:  user.ID,
+			Content:    memo.Content,
+			Visibility: memo.Visibility,
+			Payload: &storepb.MemoPayload{
+				Property: property,
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create memo")
+		}
+		if err := txStore.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:        created.ID,
+			CreatedTs: &memo.CreatedTs,
+			UpdatedTs: &memo.CreatedTs,
+		}); err != nil {
+			return errors.Wrap(err, "failed to update memo")
+		}
+		for _, resource := range resources {
+			resource.MemoID = &created.ID
+			if _, err := txStore.CreateResource(ctx, resource); err != nil {
+				return errors.Wrap(err, "failed to create resource")
+			}
+		}
+		if err := syncMemoTasks(ctx, txStore, created); err != nil {
+			return errors.Wrap(err, "failed to sync memo tasks")
+		}
+		if memo.Pinned {
+			if _, err := txStore.UpsertMemoOrganizer(ctx, &store.MemoOrganizer{
+				MemoID: created.ID,
+				UserID: user.ID,
+				Pinned: true,
+			}); err != nil {
+				return errors.Wrap(err, "failed to pin memo")
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// parseImportedMemo parses the Markdown file at memoPath. The created time and visibility
+// default to the ones encoded in the `<RFC3339>-<VISIBILITY>.md` filenames of ExportMemos.
+func parseImportedMemo(fsys fs.FS, memoPath string) (*importedMemo, error) {
+	data, err := fs.ReadFile(fsys, memoPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read file")
+	}
+	frontMatter, content, err := splitFrontMatter(string(data))
+	if err != nil {
+		return nil, err
+	}
+
+	memo := &importedMemo{
+		UID:        frontMatter.UID,
+		Visibility: store.Private,
+		Pinned:     frontMatter.Pinned,
+		Content:    strings.TrimSpace(content),
+	}
+	if memo.UID == "" {
+		memo.UID = shortuuid.New()
+	} else if !util.UIDMatcher.MatchString(memo.UID) {
+		return nil, errors.Errorf("invalid uid %s", memo.UID)
+	}
+
+	createdTime, visibility := parseExportedMemoFilename(path.Base(memoPath))
+	if frontMatter.Created != nil {
+		createdTime = *frontMatter.Created
+	}
+	if createdTime.IsZero() {
+		if info, err := fs.Stat(fsys, memoPath); err == nil && !info.ModTime().IsZero() {
+			createdTime = info.ModTime()
+		} else {
+			createdTime = time.Now()
+		}
+	}
+	memo.CreatedTs = createdTime.Unix()
+	if frontMatter.Visibility != "" {
+		visibility = store.Visibility(strings.ToUpper(frontMatter.Visibility))
+	}
+	if visibility != "" {
+		if !slices.Contains([]store.Visibility{store.Public, store.Protected, store.Private}, visibility) {
+			return nil, errors.Errorf("invalid visibility %s", visibility)
+		}
+		memo.Visibility = visibility
+	}
+
+	// Tags are derived from the content, so the ones missing from it are appended.
+	property, err := getMemoPropertyFromContent(memo.Content)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse content")
+	}
+	missingTags := []string{}
+	for _, tag := range frontMatter.Tags {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+		if tag != "" && !slices.Contains(property.Tags, tag) && !slices.Contains(missingTags, "#"+tag) {
+			missingTags = append(missingTags, "#"+tag)
+		}
+	}
+	if len(missingTags) > 0 {
+		memo.Content = strings.TrimSpace(memo.Content + "\n\n" + strings.Join(missingTags, " "))
+	}
+
+	dir := path.Dir(memoPath)
+	for _, resource := range frontMatter.Resources {
+		resourcePath, ok := resolveImportedFile(fsys, dir, resource)
+		if !ok {
+			return nil, errors.Errorf("resource %s not found", resource)
+		}
+		if !slices.Contains(memo.Resources, resourcePath) {
+			memo.Resources = append(memo.Resources, resourcePath)
+		}
+	}
+	for _, match := range markdownLinkPattern.FindAllStringSubmatch(memo.Content, -1) {
+		resourcePath, ok := resolveImportedFile(fsys, dir, match[1])
+		if ok && !isMarkdownFile(resourcePath) && !slices.Contains(memo.Resources, resourcePath) {
+			memo.Resources = append(memo.Resources, resourcePath)
+		}
+	}
+	return memo, nil
+}
+
+// splitFrontMatter splits the YAML front matter delimited by `---` lines from the content.
+func splitFrontMatter(data string) (*memoFrontMatter, string, error) {
+	frontMatter := &memoFrontMatter{}
+	data = strings.TrimPrefix(data, "\ufeff")
+	rawFrontMatter, content, ok := cutFrontMatter(data)
+	if !ok {
+		return frontMatter, data, nil
+	}
+	if err := yaml.Unmarshal([]byte(rawFrontMatter), frontMatter); err != nil {
+		return nil, "", errors.Wrap(err, "invalid front matter")
+	}
+	return frontMatter, content, nil
+}
+
+// cutFrontMatter returns the text between the leading `---` lines of the data and the text after them.
+func cutFrontMatter(data string) (string, string, bool) {
+	lines := strings.SplitAfter(data, "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
+		return "", data, false
+	}
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == "---" {
+			return strings.Join(lines[1:i], ""), strings.Join(lines[i+1:], ""), true
+		}
+	}
+	return "", data, false
+}
+
+// parseExportedMemoFilename parses the created time and visibility from filenames like
+// `2006-01-02T15:04:05Z07:00-PUBLIC.md`. Zero values are returned for other filenames.
+func parseExportedMemoFilename(filename string) (time.Time, store.Visibility) {
+	name := strings.TrimSuffix(filename, path.Ext(filename))
+	index := strings.LastIndex(name, "-")
+	if index < 0 {
+		return time.Time{}, ""
+	}
+	createdTime, err := time.Parse(time.RFC3339, name[:index])
+	if err != nil {
+		return time.Time{}, ""
+	}
+	return createdTime, store.Visibility(name[index+1:])
+}
+
+// resolveImportedFile resolves a relative reference from a Markdown file in dir to a file of fsys.
+func resolveImportedFile(fsys fs.FS, dir, reference string) (string, bool) {
+	if strings.Contains(reference, "://") || strings.HasPrefix(reference, "/") || strings.HasPrefix(reference, "#") {
+		return "", false
+	}
+	if unescaped, err := url.PathUnescape(reference); err == nil {
+		reference = unescaped
+	}
+	resourcePath := path.Join(dir, reference)
+	if !fs.ValidPath(resourcePath) {
+		return "", false
+	}
+	info, err := fs.Stat(fsys, resourcePath)
+	if err != nil || info.IsDir() {
+		return "", false
+	}
+	return resourcePath, true
+}
+
+func findOversizedFile(fsys fs.FS, paths []string, sizeLimit int64) (string, bool) {
+	for _, p := range paths {
+		info, err := fs.Stat(fsys, p)
+		if err == nil && info.Size() > sizeLimit {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+func getImportedFileType(filePath string, blob []byte) string {
+	fileType, _, _ := strings.Cut(mime.TypeByExtension(path.Ext(filePath)), ";")
+	if fileType == "" {
+		fileType, _, _ = strings.Cut(http.DetectContentType(blob), ";")
+	}
+	return strings.TrimSpace(fileType)
+}
+
+func isMarkdownFile(filePath string) bool {
+	ext := strings.ToLower(path.Ext(filePath))
+	return ext == ".md" || ext == ".markdown"
+}
@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// memoLoader converts memos from the store, batch loading the resources, relations and reactions
+// of all memos up front so that converting a page of memos takes a constant number of queries.
+type memoLoader struct {
+	workspaceMemoRelatedSetting *storepb.WorkspaceMemoRelatedSetting
+	// resources, relations and reactions are keyed by memo id.
+	resources map[int32][]*v1pb.Resource
+	relations map[int32][]*v1pb.MemoRelation
+	reactions map[int32][]*v1pb.Reaction
+}
+
+func (s *APIV1Service) newMemoLoader(ctx context.Context, memos []*store.Memo) (*memoLoader, error) {
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace memo related setting")
+	}
+	loader := &memoLoader{
+		workspaceMemoRelatedSetting: workspaceMemoRelatedSetting,
+		resources:                   map[int32][]*v1pb.Resource{},
+		relations:                   map[int32][]*v1pb.MemoRelation{},
+		reactions:                   map[int32][]*v1pb.Reaction{},
+	}
+	if len(memos) == 0 {
+		return loader, nil
+	}
+
+	memoIDs := []int32{}
+	memoIDByName := map[string]int32{}
+	for _, memo := range memos {
+		memoIDs = append(memoIDs, memo.ID)
+		memoIDByName[fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)] = memo.ID
+	}
+
+	resources, err := s.Store.ListResources(ctx, &store.FindResource{
+		MemoIDList: memoIDs,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list resources")
+	}
+	for _, resource := range resources {
+		resourceMessage := convertResourceMessageFromStore(resource)
+		memoName := fmt.Sprintf("%s%d", MemoNamePrefix, *resource.MemoID)
+		resourceMessage.Memo = &memoName
+		loader.resources[*resource.MemoID] = append(loader.resources[*resource.MemoID], resourceMessage)
+	}
+
+	// Relations of a memo list the ones from the memo first, then the ones to the memo.
+	memoRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoIDList: memoIDs,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo relations")
+	}
+	for _, memoRelation := range memoRelations {
+		loader.relations[memoRelation.MemoID] = append(loader.relations[memoRelation.MemoID], convertMemoRelationFromStore(memoRelation))
+	}
+	memoRelations, err = s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		RelatedMemoIDList: memoIDs,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo relations")
+	}
+	for _, memoRelation := range memoRelations {
+		loader.relations[memoRelation.RelatedMemoID] = append(loader.relations[memoRelation.RelatedMemoID], convertMemoRelationFromStore(memoRelation))
+	}
+
+	contentIDs := []string{}
+	for name := range memoIDByName {
+		contentIDs = append(contentIDs, name)
+	}
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{
+		ContentIDList: contentIDs,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list reactions")
+	}
+	for _, reaction := range reactions {
+		memoID := memoIDByName[reaction.ContentID]
+		loader.reactions[memoID] = append(loader.reactions[memoID], &v1pb.Reaction{
+			Id:           reaction.ID,
+			Creator:      fmt.Sprintf("%s%d", UserNamePrefix, reaction.CreatorID),
+			ContentId:    reaction.ContentID,
+			ReactionType: v1pb.Reaction_Type(reaction.ReactionType),
+		})
+	}
+	return loader, nil
+}
+
+func (l *memoLoader) convert(memo *store.Memo) (*v1pb.Memo, error) {
+	displayTs := memo.CreatedTs
+	if l.workspaceMemoRelatedSetting.DisplayWithUpdateTime {
+		displayTs = memo.UpdatedTs
+	}
+
+	nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse content")
+	}
+
+	memoMessage := &v1pb.Memo{
+		Name:        fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID),
+		Uid:         memo.UID,
+		RowStatus:   convertRowStatusFromStore(memo.RowStatus),
+		Creator:     fmt.Sprintf("%s%d", UserNamePrefix, memo.CreatorID),
+		CreateTime:  timestamppb.New(time.Unix(memo.CreatedTs, 0)),
+		UpdateTime:  timestamppb.New(time.Unix(memo.UpdatedTs, 0)),
+		DisplayTime: timestamppb.New(time.Unix(displayTs, 0)),
+		Content:     memo.Content,
+		Nodes:       convertFromASTNodes(nodes),
+		Visibility:  convertVisibilityFromStore(memo.Visibility),
+		Pinned:      memo.Pinned,
+		Relations:   l.relations[memo.ID],
+		Resources:   l.resources[memo.ID],
+		Reactions:   l.reactions[memo.ID],
+		Etag:        getMemoEtag(memo),
+	}
+	if memoMessage.Relations == nil {
+		memoMessage.Relations = []*v1pb.MemoRelation{}
+	}
+	if memoMessage.Resources == nil {
+		memoMessage.Resources = []*v1pb.Resource{}
+	}
+	if memoMessage.Reactions == nil {
+		memoMessage.Reactions = []*v1pb.Reaction{}
+	}
+	if memo.Payload != nil {
+		memoMessage.Property = convertMemoPropertyFromStore(memo.Payload.Property)
+		memoMessage.CustomProperties = convertMemoCustomPropertiesFromStore(memo.Payload.CustomProperties)
+	}
+	if schedule := memo.Payload.GetSchedule(); schedule != nil {
+		memoMessage.PublishTime = timestamppb.New(time.Unix(schedule.PublishTs, 0))
+		memoMessage.ScheduledVisibility = convertVisibilityFromStore(store.Visibility(schedule.Visibility))
+	}
+	if expiration := memo.Payload.GetExpiration(); expiration != nil {
+		memoMessage.ExpireTime = timestamppb.New(time.Unix(expiration.ExpireTs, 0))
+		memoMessage.ExpirationPolicy = convertMemoExpirationPolicyFromStore(expiration.Policy)
+	}
+	if memo.ParentID != nil {
+		parent := fmt.Sprintf("%s%d", MemoNamePrefix, *memo.ParentID)
+		memoMessage.Parent = &parent
+	}
+	if memo.DeletedTs > 0 {
+		memoMessage.DeleteTime = timestamppb.New(time.Unix(memo.DeletedTs, 0))
+	}
+	return memoMessage, nil
+}
+
+// convertMemosFromStore converts a page of memos with a constant number of queries.
+func (s *APIV1Service) convertMemosFromStore(ctx context.Context, memos []*store.Memo) ([]*v1pb.Memo, error) {
+	loader, err := s.newMemoLoader(ctx, memos)
+	if err != nil {
+		return nil, err
+	}
+	memoMessages := []*v1pb.Memo{}
+	for _, memo := range memos {
+		memoMessage, err := loader.convert(memo)
+		if err != nil {
+			return nil, err
+		}
+		memoMessages = append(memoMessages, memoMessage)
+	}
+	return memoMessages, nil
+}
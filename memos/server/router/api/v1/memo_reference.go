@@ -0,0 +1,268 @@
+package v1
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/usememos/gomark/ast"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// MemoLinkPathPrefix is the path prefix of the links to the memo pages, e.g. "/m/{uid}".
+const MemoLinkPathPrefix = "/m/"
+
+func (s *APIV1Service) ListMemoBacklinks(ctx context.Context, request *v1pb.ListMemoBacklinksRequest) (*v1pb.ListMemoBacklinksResponse, error) {
+	id, err := ExtractMemoIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	// The memo is readable the same way as in GetMemo, including the share grants and links.
+	if !isMemoVisible(memo, user) && !isMemoSharedInContext(ctx, memo.ID) {
+		shared, err := s.hasMemoSharePermission(ctx, memo, user, store.MemoSharePermissionRead)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+		}
+		if !shared {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
+
+	referenceType := store.MemoRelationReference
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		RelatedMemoID: &id,
+		Type:          &referenceType,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+	}
+	response := &v1pb.ListMemoBacklinksResponse{
+		Memos: []*v1pb.Memo{},
+	}
+	if len(relations) == 0 {
+		return response, nil
+	}
+	memoIDs := []int32{}
+	for _, relation := range relations {
+		memoIDs = append(memoIDs, relation.MemoID)
+	}
+	// The referencing memos are the ones ListMemos would return to the user.
+	normalRowStatus := store.Normal
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		IDList:    memoIDs,
+		RowStatus: &normalRowStatus,
+		Filter:    getVisibleMemoFilter(user, time.Now()),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	response.Memos, err = s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RebuildMemoReferences(ctx context.Context, request *v1pb.RebuildMemoReferencesRequest) (*emptypb.Empty, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+
+	memoFind := &store.FindMemo{
+		CreatorID: &user.ID,
+	}
+	if (request.Name) != "memos/-" {
+		memoID, err := ExtractMemoIDFromName(request.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+		}
+		memoFind.ID = &memoID
+	}
+
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos")
+	}
+	for _, memo := range memos {
+		// The existing relations are kept, as they can not be told apart from the ones set by clients.
+		if err := syncMemoReferences(ctx, s.Store, memo, ""); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to rebuild memo references: %v", err)
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// syncMemoReferences reconciles the REFERENCE relations of the memo with the memo links in its content.
+// Only the references linked from the previous content are removed, so that the relations set with
+// SetMemoRelations are kept.
+func syncMemoReferences(ctx context.Context, s *store.Store, memo *store.Memo, previousContent string) error {
+	relatedMemoIDs, err := findReferencedMemoIDs(ctx, s, memo, memo.Content)
+	if err != nil {
+		return err
+	}
+	previousRelatedMemoIDs, err := findReferencedMemoIDs(ctx, s, memo, previousContent)
+	if err != nil {
+		return err
+	}
+
+	referenceType := store.MemoRelationReference
+	for _, relatedMemoID := range previousRelatedMemoIDs {
+		if slices.Contains(relatedMemoIDs, relatedMemoID) {
+			continue
+		}
+		if err := s.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
+			MemoID:        &memo.ID,
+			RelatedMemoID: &relatedMemoID,
+			Type:          &referenceType,
+		}); err != nil {
+			return errors.Wrap(err, "failed to delete memo relation")
+		}
+	}
+	for _, relatedMemoID := range relatedMemoIDs {
+		if _, err := s.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        memo.ID,
+			RelatedMemoID: relatedMemoID,
+			Type:          referenceType,
+		}); err != nil {
+			return errors.Wrap(err, "failed to upsert memo relation")
+		}
+	}
+	return nil
+}
+
+// findReferencedMemoIDs returns the ids of the memos linked from the content, leaving out the memo itself
+// and the memos that are not visible to its creator.
+func findReferencedMemoIDs(ctx context.Context, s *store.Store, memo *store.Memo, content string) ([]int32, error) {
+	if content == "" {
+		return nil, nil
+	}
+	references, err := getMemoReferencesFromContent(content)
+	if err != nil {
+		return nil, err
+	}
+	if len(references) == 0 {
+		return nil, nil
+	}
+
+	memos, err := s.ListMemos(ctx, &store.FindMemo{
+		UIDList:        references,
+		ExcludeContent: true,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memos")
+	}
+	memoByUID := map[string]*store.Memo{}
+	for _, relatedMemo := range memos {
+		memoByUID[relatedMemo.UID] = relatedMemo
+	}
+	// Memos can be referenced by id as well, e.g. "[[memos/123]]".
+	idList := []int32{}
+	for _, reference := range references {
+		if _, ok := memoByUID[reference]; ok {
+			continue
+		}
+		if id, err := util.ConvertStringToInt32(reference); err == nil {
+			idList = append(idList, id)
+		}
+	}
+	memoByID := map[int32]*store.Memo{}
+	if len(idList) != 0 {
+		memos, err := s.ListMemos(ctx, &store.FindMemo{
+			IDList:         idList,
+			ExcludeContent: true,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to list memos")
+		}
+		for _, relatedMemo := range memos {
+			memoByID[relatedMemo.ID] = relatedMemo
+		}
+	}
+
+	memoIDs := []int32{}
+	for _, reference := range references {
+		relatedMemo := memoByUID[reference]
+		if relatedMemo == nil {
+			if id, err := util.ConvertStringToInt32(reference); err == nil {
+				relatedMemo = memoByID[id]
+			}
+		}
+		if relatedMemo == nil || relatedMemo.ID == memo.ID || slices.Contains(memoIDs, relatedMemo.ID) {
+			continue
+		}
+		if relatedMemo.Visibility == store.Private && relatedMemo.CreatorID != memo.CreatorID {
+			continue
+		}
+		memoIDs = append(memoIDs, relatedMemo.ID)
+	}
+	return memoIDs, nil
+}
+
+// getMemoReferencesFromContent returns the uids or ids of the memos referenced by the content,
+// either as "[[memos/{uid}]]", "![[memos/{uid}]]" or a link to the memo page "/m/{uid}".
+func getMemoReferencesFromContent(content string) ([]string, error) {
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse content")
+	}
+
+	references := []string{}
+	addReference := func(reference string) {
+		if reference != "" && !strings.Contains(reference, "/") && !slices.Contains(references, reference) {
+			references = append(references, reference)
+		}
+	}
+	TraverseASTNodes(nodes, func(node ast.Node) {
+		switch n := node.(type) {
+		case *ast.ReferencedContent:
+			if reference, ok := strings.CutPrefix(n.ResourceName, MemoNamePrefix); ok {
+				addReference(reference)
+			}
+		case *ast.EmbeddedContent:
+			if reference, ok := strings.CutPrefix(n.ResourceName, MemoNamePrefix); ok {
+				addReference(reference)
+			}
+		case *ast.Link:
+			addReference(getMemoReferenceFromURL(n.URL))
+		case *ast.AutoLink:
+			addReference(getMemoReferenceFromURL(n.URL))
+		}
+	})
+	return references, nil
+}
+
+// getMemoReferenceFromURL returns the uid of the memo page that the URL links to, or "" for other URLs.
+func getMemoReferenceFromURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	reference, ok := strings.CutPrefix(u.Path, MemoLinkPathPrefix)
+	if !ok {
+		return ""
+	}
+	return strings.TrimSuffix(reference, "/")
+}
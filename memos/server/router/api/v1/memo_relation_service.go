@@ -17,36 +17,51 @@ func (s *APIV1Service) SetMemoRelations(ctx context.Context, request *v1pb.SetMe
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
 	}
-	referenceType := store.MemoRelationReference
-	// Delete all reference relations first.
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
-		MemoID: &id,
-		Type:   &referenceType,
-	}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo relation")
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
 	}
-
-	for _, relation := range request.Relations {
-		// Ignore reflexive relations.
-		if request.Name == relation.RelatedMemo {
-			continue
-		}
-		// Ignore comment relations as there's no need to update a comment's relation.
-		// Inserting/Deleting a comment is handled elsewhere.
-		if relation.Type == v1pb.MemoRelation_COMMENT {
-			continue
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if err := s.Store.WithTx(ctx, func(txStore *store.Store) error {
+		referenceType := store.MemoRelationReference
+		// Delete all reference relations first, the ones linked from the content are restored below.
+		if err := txStore.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
+			MemoID: &id,
+			Type:   &referenceType,
+		}); err != nil {
+			return status.Errorf(codes.Internal, "failed to delete memo relation")
 		}
-		relatedMemoID, err := ExtractMemoIDFromName(relation.RelatedMemo)
-		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid related memo name: %v", err)
+
+		for _, relation := range request.Relations {
+			// Ignore reflexive relations.
+			if request.Name == relation.RelatedMemo {
+				continue
+			}
+			// Ignore comment relations as there's no need to update a comment's relation.
+			// Inserting/Deleting a comment is handled elsewhere.
+			if relation.Type == v1pb.MemoRelation_COMMENT {
+				continue
+			}
+			relatedMemoID, err := ExtractMemoIDFromName(relation.RelatedMemo)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid related memo name: %v", err)
+			}
+			if _, err := txStore.UpsertMemoRelation(ctx, &store.MemoRelation{
+				MemoID:        id,
+				RelatedMemoID: relatedMemoID,
+				Type:          convertMemoRelationTypeToStore(relation.Type),
+			}); err != nil {
+				return status.Errorf(codes.Internal, "failed to upsert memo relation")
+			}
 		}
-		if _, err := s.Store.UpsertMemoRelation(ctx, &store.MemoRelation{
-			MemoID:        id,
-			RelatedMemoID: relatedMemoID,
-			Type:          convertMemoRelationTypeToStore(relation.Type),
-		}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to upsert memo relation")
+		if err := syncMemoReferences(ctx, txStore, memo, ""); err != nil {
+			return status.Errorf(codes.Internal, "failed to sync memo references: %v", err)
 		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
@@ -0,0 +1,184 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListMemoRevisions(ctx context.Context, request *v1pb.ListMemoRevisionsRequest) (*v1pb.ListMemoRevisionsResponse, error) {
+	id, err := ExtractMemoIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	if _, err := s.getMemoOwnedByCurrentUser(ctx, &store.FindMemo{ID: &id}); err != nil {
+		return nil, err
+	}
+
+	memoRevisions, err := s.Store.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo revisions: %v", err)
+	}
+	response := &v1pb.ListMemoRevisionsResponse{
+		Revisions: []*v1pb.MemoRevision{},
+	}
+	for _, memoRevision := range memoRevisions {
+		response.Revisions = append(response.Revisions, convertMemoRevisionFromStore(memoRevision))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetMemoRevision(ctx context.Context, request *v1pb.GetMemoRevisionRequest) (*v1pb.GetMemoRevisionResponse, error) {
+	memoID, revisionID, err := ExtractMemoRevisionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo revision name: %v", err)
+	}
+	memo, err := s.getMemoOwnedByCurrentUser(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return nil, err
+	}
+
+	memoRevision, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{
+		ID:     &revisionID,
+		MemoID: &memoID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo revision: %v", err)
+	}
+	if memoRevision == nil {
+		return nil, status.Errorf(codes.NotFound, "memo revision not found")
+	}
+
+	response := &v1pb.GetMemoRevisionResponse{
+		Revision: convertMemoRevisionFromStore(memoRevision),
+		Diff:     []*v1pb.MemoRevisionDiffLine{},
+	}
+	for _, line := range util.DiffLines(memoRevision.Content, memo.Content) {
+		response.Diff = append(response.Diff, &v1pb.MemoRevisionDiffLine{
+			Operation: convertDiffOperationFromUtil(line.Operation),
+			Text:      line.Text,
+		})
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RestoreMemoRevision(ctx context.Context, request *v1pb.RestoreMemoRevisionRequest) (*v1pb.Memo, error) {
+	memoID, revisionID, err := ExtractMemoRevisionIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo revision name: %v", err)
+	}
+	memo, err := s.getMemoOwnedByCurrentUser(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return nil, err
+	}
+
+	memoRevision, err := s.Store.GetMemoRevision(ctx, &store.FindMemoRevision{
+		ID:     &revisionID,
+		MemoID: &memoID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo revision: %v", err)
+	}
+	if memoRevision == nil {
+		return nil, status.Errorf(codes.NotFound, "memo revision not found")
+	}
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting")
+	}
+	if workspaceMemoRelatedSetting.DisallowPublicVisible && memoRevision.Visibility == store.Public {
+		return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+	}
+
+	// The current version of the memo is kept as a new revision by the store, so restoring can be undone.
+	update := newMemoUpdate(memo, time.Now())
+	update.Content, update.Visibility, update.Payload = &memoRevision.Content, &memoRevision.Visibility, memoRevision.Payload
+	if err := s.Store.WithTx(ctx, func(txStore *store.Store) error {
+		if err := txStore.UpdateMemo(ctx, update); err != nil {
+			if errors.Is(err, store.ErrMemoChanged) {
+				return err
+			}
+			return status.Errorf(codes.Internal, "failed to restore memo revision: %v", err)
+		}
+		previousContent := memo.Content
+		memo.Content = memoRevision.Content
+		if err := syncMemoReferences(ctx, txStore, memo, previousContent); err != nil {
+			return status.Errorf(codes.Internal, "failed to sync memo references: %v", err)
+		}
+		if err := syncMemoTasks(ctx, txStore, memo); err != nil {
+			return status.Errorf(codes.Internal, "failed to sync memo tasks: %v", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, s.handleMemoChanged(ctx, memoID, err)
+	}
+
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get memo")
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	// Try to dispatch webhook when memo is updated.
+	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
+		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
+	}
+	return memoMessage, nil
+}
+
+// getMemoOwnedByCurrentUser returns the memo if it is created by the current user.
+func (s *APIV1Service) getMemoOwnedByCurrentUser(ctx context.Context, find *store.FindMemo) (*store.Memo, error) {
+	memo, err := s.Store.GetMemo(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil || memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+func convertMemoRevisionFromStore(memoRevision *store.MemoRevision) *v1pb.MemoRevision {
+	return &v1pb.MemoRevision{
+		Name:       fmt.Sprintf("%s%d/%s%d", MemoNamePrefix, memoRevision.MemoID, MemoRevisionNamePrefix, memoRevision.ID),
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, memoRevision.CreatorID),
+		CreateTime: timestamppb.New(time.Unix(memoRevision.CreatedTs, 0)),
+		Content:    memoRevision.Content,
+		Visibility: convertVisibilityFromStore(memoRevision.Visibility),
+		Property:   convertMemoPropertyFromStore(memoRevision.Payload.GetProperty()),
+	}
+}
+
+func convertDiffOperationFromUtil(operation util.DiffOperation) v1pb.MemoRevisionDiffLine_Operation {
+	switch operation {
+	case util.DiffEqual:
+		return v1pb.MemoRevisionDiffLine_EQUAL
+	case util.DiffInsert:
+		return v1pb.MemoRevisionDiffLine_INSERT
+	case util.DiffDelete:
+		return v1pb.MemoRevisionDiffLine_DELETE
+	default:
+		return v1pb.MemoRevisionDiffLine_OPERATION_UNSPECIFIED
+	}
+}
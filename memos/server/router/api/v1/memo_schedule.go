@@ -0,0 +1,109 @@
+package v1
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// PublishScheduledMemos applies the scheduled visibility of the memos whose publish time has come.
+func (s *APIV1Service) PublishScheduledMemos(ctx context.Context, now time.Time) error {
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		Filter: &store.MemoFilterAnd{
+			Filters: []store.MemoFilter{
+				&store.MemoFilterCondition{Field: store.MemoFilterFieldPublishTs, Operator: store.MemoFilterOperatorGreater, Value: int64(0)},
+				&store.MemoFilterCondition{Field: store.MemoFilterFieldPublishTs, Operator: store.MemoFilterOperatorLessOrEqual, Value: now.Unix()},
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list scheduled memos")
+	}
+	for _, memo := range memos {
+		if err := s.publishScheduledMemo(ctx, memo, now); err != nil {
+			slog.Warn("Failed to publish memo", slog.Int("memo", int(memo.ID)), slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+func (s *APIV1Service) publishScheduledMemo(ctx context.Context, memo *store.Memo, now time.Time) error {
+	visibility := store.Visibility(memo.Payload.GetSchedule().GetVisibility())
+	payload := memo.Payload
+	payload.Schedule = nil
+	// The memos changed meanwhile fail to publish here, and are published with the next run if still scheduled.
+	update := newMemoUpdate(memo, now)
+	update.Visibility, update.Payload = &visibility, payload
+	if err := s.Store.UpdateMemo(ctx, update); err != nil {
+		return errors.Wrap(err, "failed to publish memo")
+	}
+
+	memo.Visibility, memo.UpdatedTs = visibility, *update.UpdatedTs
+	memoMessage, err := s.convertMemoFromStore(ctx, memo)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
+		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
+	}
+	return nil
+}
+
+// convertMemoScheduleToStore validates the schedule of a memo, which is nil if the publish time is unset.
+func (s *APIV1Service) convertMemoScheduleToStore(ctx context.Context, publishTime *timestamppb.Timestamp, visibility v1pb.Visibility) (*storepb.MemoPayload_Schedule, error) {
+	if publishTime == nil {
+		if visibility != v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "publish time is required along with scheduled visibility")
+		}
+		return nil, nil
+	}
+	if publishTime.AsTime().Unix() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid publish time")
+	}
+	if visibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "scheduled visibility is required along with publish time")
+	}
+	scheduledVisibility := convertVisibilityToStore(visibility)
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting")
+	}
+	if workspaceMemoRelatedSetting.DisallowPublicVisible && scheduledVisibility == store.Public {
+		return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+	}
+	return &storepb.MemoPayload_Schedule{
+		PublishTs:  publishTime.AsTime().Unix(),
+		Visibility: scheduledVisibility.String(),
+	}, nil
+}
+
+// isMemoScheduled returns whether the memo has a visibility change that is not applied yet.
+// Scheduled memos are only visible to their creators.
+func isMemoScheduled(memo *store.Memo) bool {
+	return memo.Payload.GetSchedule() != nil
+}
+
+// getUnscheduledMemoFilter returns the filter of the memos that are not scheduled.
+func getUnscheduledMemoFilter() store.MemoFilter {
+	return &store.MemoFilterCondition{Field: store.MemoFilterFieldPublishTs, Operator: store.MemoFilterOperatorEqual, Value: int64(0)}
+}
+
+// GetPublishedMemoFilter returns the filter of the memos that are neither scheduled nor expired at the given time,
+// which are the memos that may be shown to anyone but their creators.
+func GetPublishedMemoFilter(now time.Time) store.MemoFilter {
+	return &store.MemoFilterAnd{
+		Filters: []store.MemoFilter{
+			getUnscheduledMemoFilter(),
+			getUnexpiredMemoFilter(now),
+		},
+	}
+}
@@ -4,19 +4,17 @@ import (
 	"archive/zip"
 	"bytes"
 	"context"
-	"fmt"
 	"log/slog"
 	"slices"
+	"strings"
 	"time"
 
-	"github.com/google/cel-go/cel"
 	"github.com/lithammer/shortuuid/v4"
 	"github.com/pkg/errors"
 	"github.com/usememos/gomark/ast"
 	"github.com/usememos/gomark/parser"
 	"github.com/usememos/gomark/parser/tokenizer"
 	"github.com/usememos/gomark/restore"
-	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -34,6 +32,11 @@ const (
 )
 
 func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoRequest) (*v1pb.Memo, error) {
+	return s.createMemo(ctx, request, nil)
+}
+
+// createMemo is CreateMemo, with the writes of beforeCreate, if any, committed in the same transaction as the memo.
+func (s *APIV1Service) createMemo(ctx context.Context, request *v1pb.CreateMemoRequest, beforeCreate func(txStore *store.Store) error) (*v1pb.Memo, error) {
 	user, err := s.GetCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
@@ -45,6 +48,23 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 		Content:    request.Content,
 		Visibility: convertVisibilityToStore(request.Visibility),
 	}
+	if request.Template != "" {
+		template, err := s.getAvailableMemoTemplate(ctx, request.Template)
+		if err != nil {
+			return nil, err
+		}
+		location, err := s.getUserLocation(ctx, user)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user location: %v", err)
+		}
+		create.Content, err = instantiateMemoTemplate(template, user, request.Content, time.Now().In(location))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to instantiate memo template: %v", err)
+		}
+		if request.Visibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+			create.Visibility = template.Visibility
+		}
+	}
 	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting")
@@ -63,14 +83,53 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo property: %v", err)
 	}
-	create.Payload = &storepb.MemoPayload{
-		Property: property,
+	schedule, err := s.convertMemoScheduleToStore(ctx, request.PublishTime, request.ScheduledVisibility)
+	if err != nil {
+		return nil, err
 	}
-
-	memo, err := s.Store.CreateMemo(ctx, create)
+	expiration, err := convertMemoExpirationToStore(request.ExpireTime, request.ExpirationPolicy)
+	if err != nil {
+		return nil, err
+	}
+	customProperties, err := convertMemoCustomPropertiesToStore(request.CustomProperties)
+	if err != nil {
+		return nil, err
+	}
+	customProperties, err = mergeMemoCustomPropertiesFromContent(customProperties, create.Content)
 	if err != nil {
 		return nil, err
 	}
+	create.Payload = &storepb.MemoPayload{
+		Property:         property,
+		Schedule:         schedule,
+		Expiration:       expiration,
+		CustomProperties: customProperties,
+	}
+
+	var memo *store.Memo
+	if err := s.Store.WithTx(ctx, func(txStore *store.Store) error {
+		if beforeCreate != nil {
+			if err := beforeCreate(txStore); err != nil {
+				return err
+			}
+		}
+		memo, err = txStore.CreateMemo(ctx, create)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to create memo: %v", err)
+		}
+		if err := syncMemoReferences(ctx, txStore, memo, ""); err != nil {
+			return status.Errorf(codes.Internal, "failed to sync memo references: %v", err)
+		}
+		if err := syncMemoTasks(ctx, txStore, memo); err != nil {
+			return status.Errorf(codes.Internal, "failed to sync memo tasks: %v", err)
+		}
+		return nil
+	}); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "failed to create memo: %v", err)
+	}
 
 	memoMessage, err := s.convertMemoFromStore(ctx, memo)
 	if err != nil {
@@ -78,7 +137,7 @@ func (s *APIV1Service) CreateMemo(ctx context.Context, request *v1pb.CreateMemoR
 	}
 	// Try to dispatch webhook when memo is created.
 	if err := s.DispatchMemoCreatedWebhook(ctx, memoMessage); err != nil {
-		slog.Warn("Failed to dispatch memo created webhook", err)
+		slog.Warn("Failed to dispatch memo created webhook", slog.Any("err", err))
 	}
 
 	return memoMessage, nil
@@ -93,43 +152,41 @@ func (s *APIV1Service) ListMemos(ctx context.Context, request *v1pb.ListMemosReq
 		return nil, status.Errorf(codes.InvalidArgument, "failed to build find memos with filter: %v", err)
 	}
 
-	var limit, offset int
-	if request.PageToken != "" {
-		var pageToken v1pb.PageToken
-		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
-		}
-		limit = int(pageToken.Limit)
-		offset = int(pageToken.Offset)
-	} else {
-		limit = int(request.PageSize)
+	limit, offset, cursor, err := parsePageToken(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
 	}
 	if limit <= 0 {
 		limit = DefaultPageSize
 	}
 	limitPlusOne := limit + 1
 	memoFind.Limit = &limitPlusOne
-	memoFind.Offset = &offset
+	if cursor != nil {
+		memoFind.Cursor = convertPageCursorToMemoCursor(cursor)
+	} else {
+		memoFind.Offset = &offset
+	}
 	memos, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
 	}
 
-	memoMessages := []*v1pb.Memo{}
 	nextPageToken := ""
 	if len(memos) == limitPlusOne {
 		memos = memos[:limit]
-		nextPageToken, err = getPageToken(limit, offset+limit)
+		// Random order has no stable position to continue from.
+		if memoFind.Random {
+			nextPageToken, err = getPageToken(limit, offset+limit)
+		} else {
+			nextPageToken, err = getCursorPageToken(limit, getMemoPageCursor(memoFind, memos[len(memos)-1]))
+		}
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
 	}
-	for _, memo := range memos {
-		memoMessage, err := s.convertMemoFromStore(ctx, memo)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert memo")
-		}
-		memoMessages = append(memoMessages, memoMessage)
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
 	}
 
 	response := &v1pb.ListMemosResponse{
@@ -150,19 +207,19 @@ func (s *APIV1Service) SearchMemos(ctx context.Context, request *v1pb.SearchMemo
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "failed to build find memos with filter: %v", err)
 	}
+	// Rank the most relevant memos first when searching with a full-text query.
+	if memoFind.ContentQuery != nil && !memoFind.Random {
+		memoFind.OrderByRelevance = true
+	}
 
 	memos, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to search memos: %v", err)
 	}
 
-	memoMessages := []*v1pb.Memo{}
-	for _, memo := range memos {
-		memoMessage, err := s.convertMemoFromStore(ctx, memo)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert memo")
-		}
-		memoMessages = append(memoMessages, memoMessage)
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
 	}
 
 	response := &v1pb.SearchMemosResponse{
@@ -182,19 +239,23 @@ func (s *APIV1Service) GetMemo(ctx context.Context, request *v1pb.GetMemoRequest
 	if err != nil {
 		return nil, err
 	}
-	if memo == nil {
+	if memo == nil || isMemoExpired(memo, time.Now()) {
 		return nil, status.Errorf(codes.NotFound, "memo not found")
 	}
-	if memo.Visibility != store.Public {
+	// Memos shared by links are visible to anyone with the share token.
+	if (memo.Visibility != store.Public || isMemoScheduled(memo)) && !isMemoSharedInContext(ctx, memo.ID) {
 		user, err := s.GetCurrentUser(ctx)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get user")
 		}
-		if user == nil {
-			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
-		}
-		if memo.Visibility == store.Private && memo.CreatorID != user.ID {
-			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		if !isMemoVisible(memo, user) {
+			shared, err := s.hasMemoSharePermission(ctx, memo, user, store.MemoSharePermissionRead)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+			}
+			if !shared {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+			}
 		}
 	}
 
@@ -227,14 +288,21 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 		return nil, status.Errorf(codes.Internal, "failed to get current user")
 	}
 	if memo.CreatorID != user.ID {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		// Collaborators with the edit permission can only update the content.
+		shared, err := s.hasMemoSharePermission(ctx, memo, user, store.MemoSharePermissionEdit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+		}
+		if !shared || len(request.UpdateMask.Paths) != 1 || request.UpdateMask.Paths[0] != "content" {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
 	}
-
-	currentTs := time.Now().Unix()
-	update := &store.UpdateMemo{
-		ID:        id,
-		UpdatedTs: &currentTs,
+	if err := s.checkMemoEtag(ctx, memo, request.Memo.Etag); err != nil {
+		return nil, err
 	}
+
+	// Updates without etags are still checked against the version read here, so that concurrent writes are not lost.
+	update := newMemoUpdate(memo, time.Now())
 	for _, path := range request.UpdateMask.Paths {
 		if path == "content" {
 			contentLengthLimit, err := s.getContentLengthLimit(ctx)
@@ -268,6 +336,46 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 				return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
 			}
 			update.Visibility = &visibility
+		} else if path == "publish_time" || path == "scheduled_visibility" {
+			schedule, err := s.convertMemoScheduleToStore(ctx, request.Memo.PublishTime, request.Memo.ScheduledVisibility)
+			if err != nil {
+				return nil, err
+			}
+			payload := memo.Payload
+			payload.Schedule = schedule
+			update.Payload = payload
+		} else if path == "expire_time" || path == "expiration_policy" {
+			expiration, err := convertMemoExpirationToStore(request.Memo.ExpireTime, request.Memo.ExpirationPolicy)
+			if err != nil {
+				return nil, err
+			}
+			payload := memo.Payload
+			payload.Expiration = expiration
+			update.Payload = payload
+		} else if path == "custom_properties" {
+			customProperties, err := convertMemoCustomPropertiesToStore(request.Memo.CustomProperties)
+			if err != nil {
+				return nil, err
+			}
+			payload := memo.Payload
+			payload.CustomProperties = customProperties
+			update.Payload = payload
+		} else if name, ok := strings.CutPrefix(path, "custom_properties."); ok {
+			// A single property is set, or removed if missing from the request.
+			payload := memo.Payload
+			if property, ok := request.Memo.CustomProperties[name]; ok {
+				customProperty, err := convertMemoCustomPropertyToStore(name, property)
+				if err != nil {
+					return nil, err
+				}
+				if payload.CustomProperties == nil {
+					payload.CustomProperties = map[string]*storepb.MemoPayload_CustomProperty{}
+				}
+				payload.CustomProperties[name] = customProperty
+			} else {
+				delete(payload.CustomProperties, name)
+			}
+			update.Payload = payload
 		} else if path == "row_status" {
 			rowStatus := convertRowStatusToStore(request.Memo.RowStatus)
 			update.RowStatus = &rowStatus
@@ -281,6 +389,10 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 				return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting")
 			}
 			if memoRelatedSetting.DisplayWithUpdateTime {
+				// The update time never moves backward, as the etags and conditional writes rely on it.
+				if displayTs <= memo.UpdatedTs {
+					return nil, status.Errorf(codes.InvalidArgument, "display time must be after the last update time")
+				}
 				update.UpdatedTs = &displayTs
 			} else {
 				update.CreatedTs = &displayTs
@@ -295,9 +407,37 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 			}
 		}
 	}
+	// The front matter of the content takes precedence over the custom properties in the request.
+	if update.Content != nil {
+		payload := memo.Payload
+		payload.CustomProperties, err = mergeMemoCustomPropertiesFromContent(payload.CustomProperties, *update.Content)
+		if err != nil {
+			return nil, err
+		}
+		update.Payload = payload
+	}
 
-	if err = s.Store.UpdateMemo(ctx, update); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to update memo")
+	if err := s.Store.WithTx(ctx, func(txStore *store.Store) error {
+		if err := txStore.UpdateMemo(ctx, update); err != nil {
+			if errors.Is(err, store.ErrMemoChanged) {
+				return err
+			}
+			return status.Errorf(codes.Internal, "failed to update memo")
+		}
+		if update.Content == nil {
+			return nil
+		}
+		previousContent := memo.Content
+		memo.Content = *update.Content
+		if err := syncMemoReferences(ctx, txStore, memo, previousContent); err != nil {
+			return status.Errorf(codes.Internal, "failed to sync memo references: %v", err)
+		}
+		if err := syncMemoTasks(ctx, txStore, memo); err != nil {
+			return status.Errorf(codes.Internal, "failed to sync memo tasks: %v", err)
+		}
+		return nil
+	}); err != nil {
+		return nil, s.handleMemoChanged(ctx, id, err)
 	}
 
 	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{
@@ -312,7 +452,7 @@ func (s *APIV1Service) UpdateMemo(ctx context.Context, request *v1pb.UpdateMemoR
 	}
 	// Try to dispatch webhook when memo is updated.
 	if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
-		slog.Warn("Failed to dispatch memo updated webhook", err)
+		slog.Warn("Failed to dispatch memo updated webhook", slog.Any("err", err))
 	}
 
 	return memoMessage, nil
@@ -340,52 +480,30 @@ func (s *APIV1Service) DeleteMemo(ctx context.Context, request *v1pb.DeleteMemoR
 	if memo.CreatorID != user.ID {
 		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
 	}
-
-	if memoMessage, err := s.convertMemoFromStore(ctx, memo); err == nil {
-		// Try to dispatch webhook when memo is deleted.
-		if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
-			slog.Warn("Failed to dispatch memo deleted webhook", err)
-		}
-	}
-
-	if err = s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: id}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo")
+	if err := s.checkMemoEtag(ctx, memo, request.Etag); err != nil {
+		return nil, err
 	}
 
-	// Delete memo relation
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &id}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo relations")
+	// Move the memo along with its resources and comments into the trash bin.
+	if request.Etag != "" {
+		err = s.Store.TrashMemoIfUnchanged(ctx, id, memo.UpdatedTs)
+	} else {
+		err = s.Store.TrashMemo(ctx, id)
 	}
-
-	// Delete related resources.
-	resources, err := s.Store.ListResources(ctx, &store.FindResource{MemoID: &id})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list resources")
-	}
-	for _, resource := range resources {
-		if err := s.Store.DeleteResource(ctx, &store.DeleteResource{ID: resource.ID}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete resource")
+		if errors.Is(err, store.ErrMemoChanged) {
+			return nil, s.handleMemoChanged(ctx, id, err)
 		}
+		return nil, status.Errorf(codes.Internal, "failed to delete memo: %v", err)
 	}
 
-	// Delete memo comments
-	commentType := store.MemoRelationComment
-	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoID: &id, Type: &commentType})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memo comments")
-	}
-	for _, relation := range relations {
-		if _, err := s.DeleteMemo(ctx, &v1pb.DeleteMemoRequest{Name: fmt.Sprintf("%s%d", MemoNamePrefix, relation.MemoID)}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to delete memo comment")
+	if memoMessage, err := s.convertMemoFromStore(ctx, memo); err == nil {
+		// Try to dispatch webhook when memo is deleted.
+		if err := s.DispatchMemoDeletedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo deleted webhook", slog.Any("err", err))
 		}
 	}
 
-	// Delete memo references
-	referenceType := store.MemoRelationReference
-	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{RelatedMemoID: &id, Type: &referenceType}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete memo references")
-	}
-
 	return &emptypb.Empty{}, nil
 }
 
@@ -398,6 +516,22 @@ func (s *APIV1Service) CreateMemoComment(ctx context.Context, request *v1pb.Crea
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if relatedMemo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if !isMemoVisible(relatedMemo, user) {
+		shared, err := s.hasMemoSharePermission(ctx, relatedMemo, user, store.MemoSharePermissionComment)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+		}
+		if !shared {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
 
 	// Create the comment memo first.
 	memo, err := s.CreateMemo(ctx, request.Comment)
@@ -458,34 +592,43 @@ func (s *APIV1Service) ListMemoComments(ctx context.Context, request *v1pb.ListM
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
 	}
-	memoRelationComment := store.MemoRelationComment
-	memoRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
-		RelatedMemoID: &id,
-		Type:          &memoRelationComment,
-	})
+	memoFind := &store.FindMemo{
+		ParentID: &id,
+	}
+	limit, offset, cursor, err := parsePageToken(request.PageSize, request.PageToken)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memo relations")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	}
+	// List all comments unless a page is requested.
+	limitPlusOne := limit + 1
+	if limit > 0 {
+		memoFind.Limit = &limitPlusOne
+		memoFind.Offset = &offset
+	}
+	if cursor != nil {
+		memoFind.Cursor = convertPageCursorToMemoCursor(cursor)
+	}
+	comments, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo comments")
 	}
 
-	var memos []*v1pb.Memo
-	for _, memoRelation := range memoRelations {
-		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
-			ID: &memoRelation.MemoID,
-		})
+	nextPageToken := ""
+	if limit > 0 && len(comments) == limitPlusOne {
+		comments = comments[:limit]
+		nextPageToken, err = getCursorPageToken(limit, getMemoPageCursor(memoFind, comments[len(comments)-1]))
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get memo")
-		}
-		if memo != nil {
-			memoMessage, err := s.convertMemoFromStore(ctx, memo)
-			if err != nil {
-				return nil, errors.Wrap(err, "failed to convert memo")
-			}
-			memos = append(memos, memoMessage)
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
 	}
+	memos, err := s.convertMemosFromStore(ctx, comments)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
+	}
 
 	response := &v1pb.ListMemoCommentsResponse{
-		Memos: memos,
+		Memos:         memos,
+		NextPageToken: nextPageToken,
 	}
 	return response, nil
 }
@@ -561,13 +704,14 @@ func (s *APIV1Service) ExportMemos(ctx context.Context, request *v1pb.ExportMemo
 		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
 	}
 
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
+	}
 	buf := new(bytes.Buffer)
 	writer := zip.NewWriter(buf)
-	for _, memo := range memos {
-		memoMessage, err := s.convertMemoFromStore(ctx, memo)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert memo")
-		}
+	for i, memo := range memos {
+		memoMessage := memoMessages[i]
 		file, err := writer.Create(time.Unix(memo.CreatedTs, 0).Format(time.RFC3339) + "-" + string(memo.Visibility) + ".md")
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Failed to create memo file")
@@ -655,12 +799,21 @@ func (s *APIV1Service) RebuildMemoProperty(ctx context.Context, request *v1pb.Re
 			return nil, status.Errorf(codes.Internal, "failed to get memo property: %v", err)
 		}
 		memo.Payload.Property = property
+		// The property is derived from the content, so the update time is kept. The memos changed meanwhile already have it rebuilt.
+		expectedUpdatedTs := memo.UpdatedTs
 		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
-			ID:      memo.ID,
-			Payload: memo.Payload,
+			ID:                memo.ID,
+			Payload:           memo.Payload,
+			ExpectedUpdatedTs: &expectedUpdatedTs,
 		}); err != nil {
+			if errors.Is(err, store.ErrMemoChanged) {
+				continue
+			}
 			return nil, status.Errorf(codes.Internal, "failed to update memo")
 		}
+		if err := syncMemoTasks(ctx, s.Store, memo); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to sync memo tasks: %v", err)
+		}
 	}
 
 	return &emptypb.Empty{}, nil
@@ -697,9 +850,22 @@ func (s *APIV1Service) ListMemoTags(ctx context.Context, request *v1pb.ListMemoT
 			}
 		}
 	}
-	return &v1pb.ListMemoTagsResponse{
-		TagAmounts: tagAmounts,
-	}, nil
+	tagNames := []string{}
+	for tag := range tagAmounts {
+		tagNames = append(tagNames, tag)
+	}
+	tagMetadata, err := s.listTagMetadata(ctx, memos, tagNames)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tag metadata: %v", err)
+	}
+	response := &v1pb.ListMemoTagsResponse{
+		TagAmounts:  tagAmounts,
+		TagMetadata: tagMetadata,
+	}
+	if request.Tree {
+		response.TagTree = buildMemoTagTree(memos)
+	}
+	return response, nil
 }
 
 func (s *APIV1Service) RenameMemoTag(ctx context.Context, request *v1pb.RenameMemoTagRequest) (*emptypb.Empty, error) {
@@ -713,6 +879,10 @@ func (s *APIV1Service) RenameMemoTag(ctx context.Context, request *v1pb.RenameMe
 		PayloadFind:     &store.FindMemoPayload{Tag: &request.OldTag},
 		ExcludeComments: true,
 	}
+	if request.IncludeDescendants {
+		memoFind.PayloadFind = nil
+		memoFind.Filter = &store.MemoFilterCondition{Field: store.MemoFilterFieldTag, Operator: store.MemoFilterOperatorSubtree, Value: request.OldTag}
+	}
 	if (request.Parent) != "memos/-" {
 		memoID, err := ExtractMemoIDFromName(request.Parent)
 		if err != nil {
@@ -726,31 +896,55 @@ func (s *APIV1Service) RenameMemoTag(ctx context.Context, request *v1pb.RenameMe
 		return nil, status.Errorf(codes.Internal, "failed to list memos")
 	}
 
-	for _, memo := range memos {
-		nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse memo: %v", err)
-		}
-		TraverseASTNodes(nodes, func(node ast.Node) {
-			if tag, ok := node.(*ast.Tag); ok && tag.Content == request.OldTag {
-				tag.Content = request.NewTag
+	// Rename the tag in all memos atomically.
+	now := time.Now()
+	if err := s.Store.WithTx(ctx, func(txStore *store.Store) error {
+		for _, memo := range memos {
+			nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to parse memo: %v", err)
 			}
-		})
-		content := restore.Restore(nodes)
+			TraverseASTNodes(nodes, func(node ast.Node) {
+				tag, ok := node.(*ast.Tag)
+				if !ok {
+					return
+				}
+				if tag.Content == request.OldTag {
+					tag.Content = request.NewTag
+				} else if request.IncludeDescendants && isTagInSubtree(tag.Content, request.OldTag) {
+					tag.Content = request.NewTag + strings.TrimPrefix(tag.Content, request.OldTag)
+				}
+			})
+			content := restore.Restore(nodes)
 
-		property, err := getMemoPropertyFromContent(content)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get memo property: %v", err)
+			property, err := getMemoPropertyFromContent(content)
+			if err != nil {
+				return status.Errorf(codes.Internal, "failed to get memo property: %v", err)
+			}
+			payload := memo.Payload
+			payload.Property = property
+			update := newMemoUpdate(memo, now)
+			update.Content, update.Payload = &content, payload
+			if err := txStore.UpdateMemo(ctx, update); err != nil {
+				if errors.Is(err, store.ErrMemoChanged) {
+					return status.Errorf(codes.FailedPrecondition, "memo %d has been changed meanwhile", memo.ID)
+				}
+				return status.Errorf(codes.Internal, "failed to update memo: %v", err)
+			}
+			memo.Content = content
+			if err := syncMemoTasks(ctx, txStore, memo); err != nil {
+				return status.Errorf(codes.Internal, "failed to sync memo tasks: %v", err)
+			}
 		}
-		payload := memo.Payload
-		payload.Property = property
-		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
-			ID:      memo.ID,
-			Content: &content,
-			Payload: payload,
-		}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
+		// The metadata of the tag follows the tag only if it is renamed in all memos.
+		if request.Parent == "memos/-" {
+			if err := renameTags(ctx, txStore, user.ID, request.OldTag, request.NewTag, request.IncludeDescendants); err != nil {
+				return status.Errorf(codes.Internal, "failed to rename tags: %v", err)
+			}
 		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
@@ -768,6 +962,10 @@ func (s *APIV1Service) DeleteMemoTag(ctx context.Context, request *v1pb.DeleteMe
 		ExcludeContent:  true,
 		ExcludeComments: true,
 	}
+	if request.IncludeDescendants {
+		memoFind.PayloadFind = nil
+		memoFind.Filter = &store.MemoFilterCondition{Field: store.MemoFilterFieldTag, Operator: store.MemoFilterOperatorSubtree, Value: request.Tag}
+	}
 	if (request.Parent) != "memos/-" {
 		memoID, err := ExtractMemoIDFromName(request.Parent)
 		if err != nil {
@@ -781,87 +979,44 @@ func (s *APIV1Service) DeleteMemoTag(ctx context.Context, request *v1pb.DeleteMe
 		return nil, status.Errorf(codes.Internal, "failed to list memos")
 	}
 
-	for _, memo := range memos {
-		if request.DeleteRelatedMemos {
-			err := s.Store.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID})
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to delete memo")
+	now := time.Now()
+	if err := s.Store.WithTx(ctx, func(txStore *store.Store) error {
+		for _, memo := range memos {
+			var err error
+			if request.DeleteRelatedMemos {
+				err = txStore.TrashMemoIfUnchanged(ctx, memo.ID, memo.UpdatedTs)
+			} else {
+				archived := store.Archived
+				update := newMemoUpdate(memo, now)
+				update.RowStatus = &archived
+				err = txStore.UpdateMemo(ctx, update)
+			}
+			if errors.Is(err, store.ErrMemoChanged) {
+				return status.Errorf(codes.FailedPrecondition, "memo %d has been changed meanwhile", memo.ID)
 			}
-		} else {
-			archived := store.Archived
-			err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
-				ID:        memo.ID,
-				RowStatus: &archived,
-			})
 			if err != nil {
-				return nil, status.Errorf(codes.Internal, "failed to update memo")
+				return status.Errorf(codes.Internal, "failed to update memo: %v", err)
+			}
+		}
+		if request.Parent == "memos/-" {
+			if err := deleteTags(ctx, txStore, user.ID, request.Tag, request.IncludeDescendants); err != nil {
+				return status.Errorf(codes.Internal, "failed to delete tags: %v", err)
 			}
 		}
+		return nil
+	}); err != nil {
+		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
 func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Memo) (*v1pb.Memo, error) {
-	displayTs := memo.CreatedTs
-	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	memoMessages, err := s.convertMemosFromStore(ctx, []*store.Memo{memo})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get workspace memo related setting")
-	}
-	if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
-		displayTs = memo.UpdatedTs
-	}
-
-	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &memo.CreatorID})
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to get creator")
-	}
-
-	name := fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)
-	listMemoRelationsResponse, err := s.ListMemoRelations(ctx, &v1pb.ListMemoRelationsRequest{Name: name})
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to list memo relations")
-	}
-
-	listMemoResourcesResponse, err := s.ListMemoResources(ctx, &v1pb.ListMemoResourcesRequest{Name: name})
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to list memo resources")
-	}
-
-	listMemoReactionsResponse, err := s.ListMemoReactions(ctx, &v1pb.ListMemoReactionsRequest{Name: name})
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to list memo reactions")
-	}
-
-	nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to parse content")
-	}
-
-	memoMessage := &v1pb.Memo{
-		Name:        name,
-		Uid:         memo.UID,
-		RowStatus:   convertRowStatusFromStore(memo.RowStatus),
-		Creator:     fmt.Sprintf("%s%d", UserNamePrefix, creator.ID),
-		CreateTime:  timestamppb.New(time.Unix(memo.CreatedTs, 0)),
-		UpdateTime:  timestamppb.New(time.Unix(memo.UpdatedTs, 0)),
-		DisplayTime: timestamppb.New(time.Unix(displayTs, 0)),
-		Content:     memo.Content,
-		Nodes:       convertFromASTNodes(nodes),
-		Visibility:  convertVisibilityFromStore(memo.Visibility),
-		Pinned:      memo.Pinned,
-		Relations:   listMemoRelationsResponse.Relations,
-		Resources:   listMemoResourcesResponse.Resources,
-		Reactions:   listMemoReactionsResponse.Reactions,
-	}
-	if memo.Payload != nil {
-		memoMessage.Property = convertMemoPropertyFromStore(memo.Payload.Property)
-	}
-	if memo.ParentID != nil {
-		parent := fmt.Sprintf("%s%d", MemoNamePrefix, *memo.ParentID)
-		memoMessage.Parent = &parent
+		return nil, err
 	}
-	return memoMessage, nil
+	return memoMessages[0], nil
 }
 
 func convertMemoPropertyFromStore(property *storepb.MemoPayload_Property) *v1pb.MemoProperty {
@@ -903,6 +1058,27 @@ func convertVisibilityToStore(visibility v1pb.Visibility) store.Visibility {
 	}
 }
 
+// getMemoPageCursor returns the cursor of the memo in the list order of the find.
+func getMemoPageCursor(find *store.FindMemo, memo *store.Memo) *v1pb.PageCursor {
+	displayTs := memo.CreatedTs
+	if find.OrderByUpdatedTs {
+		displayTs = memo.UpdatedTs
+	}
+	return &v1pb.PageCursor{
+		Pinned:    find.OrderByPinned && memo.Pinned,
+		DisplayTs: displayTs,
+		Id:        memo.ID,
+	}
+}
+
+func convertPageCursorToMemoCursor(cursor *v1pb.PageCursor) *store.MemoCursor {
+	return &store.MemoCursor{
+		Pinned:    cursor.Pinned,
+		DisplayTs: cursor.DisplayTs,
+		ID:        cursor.Id,
+	}
+}
+
 func (s *APIV1Service) buildMemoFindWithFilter(ctx context.Context, find *store.FindMemo, filter string) error {
 	if find == nil {
 		find = &store.FindMemo{}
@@ -910,48 +1086,22 @@ func (s *APIV1Service) buildMemoFindWithFilter(ctx context.Context, find *store.
 	if find.PayloadFind == nil {
 		find.PayloadFind = &store.FindMemoPayload{}
 	}
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace memo related setting")
+	}
+	collectionIDs := []int32{}
 	if filter != "" {
-		filter, err := parseSearchMemosFilter(filter)
+		filter, err := parseSearchMemosFilter(filter, workspaceMemoRelatedSetting.DisplayWithUpdateTime)
 		if err != nil {
 			return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 		}
-		if len(filter.ContentSearch) > 0 {
-			find.ContentSearch = filter.ContentSearch
-		}
-		if len(filter.Visibilities) > 0 {
-			find.VisibilityList = filter.Visibilities
-		}
-		if filter.Tag != nil {
-			if find.PayloadFind == nil {
-				find.PayloadFind = &store.FindMemoPayload{}
-			}
-			find.PayloadFind.Tag = filter.Tag
+		if filter.Query != nil {
+			find.ContentQuery = filter.Query
 		}
 		if filter.OrderByPinned {
 			find.OrderByPinned = filter.OrderByPinned
 		}
-		if filter.DisplayTimeAfter != nil {
-			workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
-			if err != nil {
-				return status.Errorf(codes.Internal, "failed to get workspace memo related setting")
-			}
-			if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
-				find.UpdatedTsAfter = filter.DisplayTimeAfter
-			} else {
-				find.CreatedTsAfter = filter.DisplayTimeAfter
-			}
-		}
-		if filter.DisplayTimeBefore != nil {
-			workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
-			if err != nil {
-				return status.Errorf(codes.Internal, "failed to get workspace memo related setting")
-			}
-			if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
-				find.UpdatedTsBefore = filter.DisplayTimeBefore
-			} else {
-				find.CreatedTsBefore = filter.DisplayTimeBefore
-			}
-		}
 		if filter.Creator != nil {
 			userID, err := ExtractUserIDFromName(*filter.Creator)
 			if err != nil {
@@ -968,12 +1118,6 @@ func (s *APIV1Service) buildMemoFindWithFilter(ctx context.Context, find *store.
 			}
 			find.CreatorID = &user.ID
 		}
-		if filter.UID != nil {
-			find.UID = filter.UID
-		}
-		if filter.RowStatus != nil {
-			find.RowStatus = filter.RowStatus
-		}
 		if filter.Random {
 			find.Random = filter.Random
 		}
@@ -983,24 +1127,22 @@ func (s *APIV1Service) buildMemoFindWithFilter(ctx context.Context, find *store.
 		if filter.IncludeComments {
 			find.ExcludeComments = false
 		}
-		if filter.HasLink {
-			find.PayloadFind.HasLink = true
-		}
-		if filter.HasTaskList {
-			find.PayloadFind.HasTaskList = true
-		}
-		if filter.HasCode {
-			find.PayloadFind.HasCode = true
-		}
-		if filter.HasIncompleteTasks {
-			find.PayloadFind.HasIncompleteTasks = true
+		if filter.Filter != nil {
+			find.Filter = filter.Filter
 		}
+		collectionIDs = filter.CollectionIDs
 	}
 
 	user, err := s.GetCurrentUser(ctx)
 	if err != nil {
 		return status.Errorf(codes.Internal, "failed to get current user")
 	}
+	// The memos of a collection can only be listed if the collection is visible.
+	for _, collectionID := range collectionIDs {
+		if _, err := s.getVisibleCollection(ctx, collectionID, user); err != nil {
+			return err
+		}
+	}
 	// If the user is not authenticated, only public memos are visible.
 	if user == nil {
 		if filter == "" {
@@ -1009,156 +1151,96 @@ func (s *APIV1Service) buildMemoFindWithFilter(ctx context.Context, find *store.
 		}
 
 		find.VisibilityList = []store.Visibility{store.Public}
+		appendMemoFilter(find, getUnscheduledMemoFilter())
 	} else if find.CreatorID != nil && *find.CreatorID != user.ID {
-		find.VisibilityList = []store.Visibility{store.Public, store.Protected}
+		appendMemoFilter(find, getSharedOrVisibleMemoFilter(user))
+	} else if find.CreatorID == nil {
+		// Private and scheduled memos of other users are only visible if shared with the user.
+		appendMemoFilter(find, &store.MemoFilterOr{
+			Filters: []store.MemoFilter{
+				&store.MemoFilterCondition{Field: store.MemoFilterFieldCreatorID, Operator: store.MemoFilterOperatorEqual, Value: user.ID},
+				getSharedOrVisibleMemoFilter(user),
+			},
+		})
 	}
+	// Expired memos are hidden from everyone, including their creators.
+	appendMemoFilter(find, getUnexpiredMemoFilter(time.Now()))
 
-	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
-	if err != nil {
-		return status.Errorf(codes.Internal, "failed to get workspace memo related setting")
-	}
 	if workspaceMemoRelatedSetting.DisplayWithUpdateTime {
 		find.OrderByUpdatedTs = true
 	}
 	return nil
 }
 
-func (s *APIV1Service) getContentLengthLimit(ctx context.Context) (int, error) {
-	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
-	if err != nil {
-		return 0, status.Errorf(codes.Internal, "failed to get workspace memo related setting")
+// getSharedOrVisibleMemoFilter returns the filter of the memos of other users that are visible to the user,
+// either by their visibility or by being shared with the user.
+func getSharedOrVisibleMemoFilter(user *store.User) store.MemoFilter {
+	return &store.MemoFilterOr{
+		Filters: []store.MemoFilter{
+			&store.MemoFilterAnd{
+				Filters: []store.MemoFilter{
+					&store.MemoFilterCondition{Field: store.MemoFilterFieldVisibility, Operator: store.MemoFilterOperatorIn, Value: []any{store.Public.String(), store.Protected.String()}},
+					getUnscheduledMemoFilter(),
+				},
+			},
+			&store.MemoFilterCondition{Field: store.MemoFilterFieldSharedWith, Operator: store.MemoFilterOperatorEqual, Value: user.ID},
+		},
 	}
-	return int(workspaceMemoRelatedSetting.ContentLengthLimit), nil
 }
 
-// SearchMemosFilterCELAttributes are the CEL attributes.
-var SearchMemosFilterCELAttributes = []cel.EnvOption{
-	cel.Variable("content_search", cel.ListType(cel.StringType)),
-	cel.Variable("visibilities", cel.ListType(cel.StringType)),
-	cel.Variable("tag", cel.StringType),
-	cel.Variable("order_by_pinned", cel.BoolType),
-	cel.Variable("display_time_before", cel.IntType),
-	cel.Variable("display_time_after", cel.IntType),
-	cel.Variable("creator", cel.StringType),
-	cel.Variable("uid", cel.StringType),
-	cel.Variable("row_status", cel.StringType),
-	cel.Variable("random", cel.BoolType),
-	cel.Variable("limit", cel.IntType),
-	cel.Variable("include_comments", cel.BoolType),
-	cel.Variable("has_link", cel.BoolType),
-	cel.Variable("has_task_list", cel.BoolType),
-	cel.Variable("has_code", cel.BoolType),
-	cel.Variable("has_incomplete_tasks", cel.BoolType),
+// getVisibleMemoFilter returns the filter of the memos visible to the user, who is nil if not signed in,
+// that is the memos matching isMemoVisible and the unexpired memos shared with the user.
+func getVisibleMemoFilter(user *store.User, now time.Time) store.MemoFilter {
+	var visibleFilter store.MemoFilter = &store.MemoFilterAnd{
+		Filters: []store.MemoFilter{
+			&store.MemoFilterCondition{Field: store.MemoFilterFieldVisibility, Operator: store.MemoFilterOperatorIn, Value: []any{store.Public.String()}},
+			getUnscheduledMemoFilter(),
+		},
+	}
+	if user != nil {
+		visibleFilter = &store.MemoFilterOr{
+			Filters: []store.MemoFilter{
+				&store.MemoFilterCondition{Field: store.MemoFilterFieldCreatorID, Operator: store.MemoFilterOperatorEqual, Value: user.ID},
+				getSharedOrVisibleMemoFilter(user),
+			},
+		}
+	}
+	return &store.MemoFilterAnd{Filters: []store.MemoFilter{visibleFilter, getUnexpiredMemoFilter(now)}}
 }
 
-type SearchMemosFilter struct {
-	ContentSearch      []string
-	Visibilities       []store.Visibility
-	Tag                *string
-	OrderByPinned      bool
-	DisplayTimeBefore  *int64
-	DisplayTimeAfter   *int64
-	Creator            *string
-	UID                *string
-	RowStatus          *store.RowStatus
-	Random             bool
-	Limit              *int
-	IncludeComments    bool
-	HasLink            bool
-	HasTaskList        bool
-	HasCode            bool
-	HasIncompleteTasks bool
+// appendMemoFilter narrows the filter of the find with the given filter.
+func appendMemoFilter(find *store.FindMemo, filter store.MemoFilter) {
+	if find.Filter != nil {
+		find.Filter = &store.MemoFilterAnd{Filters: []store.MemoFilter{find.Filter, filter}}
+	} else {
+		find.Filter = filter
+	}
 }
 
-func parseSearchMemosFilter(expression string) (*SearchMemosFilter, error) {
-	e, err := cel.NewEnv(SearchMemosFilterCELAttributes...)
-	if err != nil {
-		return nil, err
+// isMemoVisible returns whether the memo is visible to the user, who is nil if not signed in.
+// Expired memos are hidden from everyone, including their creators.
+func isMemoVisible(memo *store.Memo, user *store.User) bool {
+	if isMemoExpired(memo, time.Now()) {
+		return false
 	}
-	ast, issues := e.Compile(expression)
-	if issues != nil {
-		return nil, errors.Errorf("found issue %v", issues)
+	if user != nil && memo.CreatorID == user.ID {
+		return true
 	}
-	filter := &SearchMemosFilter{}
-	expr, err := cel.AstToParsedExpr(ast)
-	if err != nil {
-		return nil, err
+	if isMemoScheduled(memo) {
+		return false
 	}
-	callExpr := expr.GetExpr().GetCallExpr()
-	findSearchMemosField(callExpr, filter)
-	return filter, nil
+	if memo.Visibility == store.Public {
+		return true
+	}
+	return user != nil && memo.Visibility != store.Private
 }
 
-func findSearchMemosField(callExpr *expr.Expr_Call, filter *SearchMemosFilter) {
-	if len(callExpr.Args) == 2 {
-		idExpr := callExpr.Args[0].GetIdentExpr()
-		if idExpr != nil {
-			if idExpr.Name == "content_search" {
-				contentSearch := []string{}
-				for _, expr := range callExpr.Args[1].GetListExpr().GetElements() {
-					value := expr.GetConstExpr().GetStringValue()
-					contentSearch = append(contentSearch, value)
-				}
-				filter.ContentSearch = contentSearch
-			} else if idExpr.Name == "visibilities" {
-				visibilities := []store.Visibility{}
-				for _, expr := range callExpr.Args[1].GetListExpr().GetElements() {
-					value := expr.GetConstExpr().GetStringValue()
-					visibilities = append(visibilities, store.Visibility(value))
-				}
-				filter.Visibilities = visibilities
-			} else if idExpr.Name == "tag" {
-				tag := callExpr.Args[1].GetConstExpr().GetStringValue()
-				filter.Tag = &tag
-			} else if idExpr.Name == "order_by_pinned" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.OrderByPinned = value
-			} else if idExpr.Name == "display_time_before" {
-				displayTimeBefore := callExpr.Args[1].GetConstExpr().GetInt64Value()
-				filter.DisplayTimeBefore = &displayTimeBefore
-			} else if idExpr.Name == "display_time_after" {
-				displayTimeAfter := callExpr.Args[1].GetConstExpr().GetInt64Value()
-				filter.DisplayTimeAfter = &displayTimeAfter
-			} else if idExpr.Name == "creator" {
-				creator := callExpr.Args[1].GetConstExpr().GetStringValue()
-				filter.Creator = &creator
-			} else if idExpr.Name == "uid" {
-				uid := callExpr.Args[1].GetConstExpr().GetStringValue()
-				filter.UID = &uid
-			} else if idExpr.Name == "row_status" {
-				rowStatus := store.RowStatus(callExpr.Args[1].GetConstExpr().GetStringValue())
-				filter.RowStatus = &rowStatus
-			} else if idExpr.Name == "random" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.Random = value
-			} else if idExpr.Name == "limit" {
-				limit := int(callExpr.Args[1].GetConstExpr().GetInt64Value())
-				filter.Limit = &limit
-			} else if idExpr.Name == "include_comments" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.IncludeComments = value
-			} else if idExpr.Name == "has_link" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.HasLink = value
-			} else if idExpr.Name == "has_task_list" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.HasTaskList = value
-			} else if idExpr.Name == "has_code" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.HasCode = value
-			} else if idExpr.Name == "has_incomplete_tasks" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.HasIncompleteTasks = value
-			}
-			return
-		}
-	}
-	for _, arg := range callExpr.Args {
-		callExpr := arg.GetCallExpr()
-		if callExpr != nil {
-			findSearchMemosField(callExpr, filter)
-		}
+func (s *APIV1Service) getContentLengthLimit(ctx context.Context) (int, error) {
+	workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to get workspace memo related setting")
 	}
+	return int(workspaceMemoRelatedSetting.ContentLengthLimit), nil
 }
 
 func getMemoPropertyFromContent(content string) (*storepb.MemoPayload_Property, error) {
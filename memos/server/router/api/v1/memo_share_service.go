@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// memoShareTokenLength is the length of the random tokens of memo share links.
+const memoShareTokenLength = 32
+
+func (s *APIV1Service) CreateMemoShare(ctx context.Context, request *v1pb.CreateMemoShareRequest) (*v1pb.MemoShare, error) {
+	memo, err := s.getCurrentUserMemo(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	create := &store.MemoShareLink{
+		MemoID:    memo.ID,
+		CreatorID: memo.CreatorID,
+	}
+	if share := request.Share; share != nil {
+		if share.ExpireTime != nil {
+			create.ExpireTs = share.ExpireTime.AsTime().Unix()
+			if create.ExpireTs <= time.Now().Unix() {
+				return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+			}
+		}
+		if share.Password != "" {
+			passwordHash, err := bcrypt.GenerateFromPassword([]byte(share.Password), bcrypt.DefaultCost)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to generate password hash: %v", err)
+			}
+			create.PasswordHash = string(passwordHash)
+		}
+	}
+	token, err := util.RandomString(memoShareTokenLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate share token: %v", err)
+	}
+	create.Token = token
+
+	link, err := s.Store.CreateMemoShareLink(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo share: %v", err)
+	}
+	return convertMemoShareLinkFromStore(link), nil
+}
+
+func (s *APIV1Service) ListMemoShares(ctx context.Context, request *v1pb.ListMemoSharesRequest) (*v1pb.ListMemoSharesResponse, error) {
+	memo, err := s.getCurrentUserMemo(ctx, request.Parent)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := s.Store.ListMemoShareLinks(ctx, &store.FindMemoShareLink{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo shares: %v", err)
+	}
+	response := &v1pb.ListMemoSharesResponse{
+		Shares: []*v1pb.MemoShare{},
+	}
+	for _, link := range links {
+		response.Shares = append(response.Shares, convertMemoShareLinkFromStore(link))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RevokeMemoShare(ctx context.Context, request *v1pb.RevokeMemoShareRequest) (*emptypb.Empty, error) {
+	memoID, shareID, err := ExtractMemoShareIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo share name: %v", err)
+	}
+	memo, err := s.getCurrentUserMemo(ctx, fmt.Sprintf("%s%d", MemoNamePrefix, memoID))
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := s.Store.GetMemoShareLink(ctx, &store.FindMemoShareLink{
+		ID:     &shareID,
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+	}
+	if link == nil {
+		return nil, status.Errorf(codes.NotFound, "memo share not found")
+	}
+	if err := s.Store.DeleteMemoShareLink(ctx, &store.DeleteMemoShareLink{
+		ID: &link.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke memo share: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getCurrentUserMemo returns the memo with the given name, which must be created by the current user.
+func (s *APIV1Service) getCurrentUserMemo(ctx context.Context, name string) (*store.Memo, error) {
+	memoID, err := ExtractMemoIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		ID:             &memoID,
+		ExcludeContent: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+// isMemoSharedInContext returns whether the request has been granted access to the memo by a share link.
+func isMemoSharedInContext(ctx context.Context, memoID int32) bool {
+	link, ok := ctx.Value(memoShareLinkContextKey).(*store.MemoShareLink)
+	return ok && link.MemoID == memoID
+}
+
+func convertMemoShareLinkFromStore(link *store.MemoShareLink) *v1pb.MemoShare {
+	share := &v1pb.MemoShare{
+		Name:        fmt.Sprintf("%s%d/%s%d", MemoNamePrefix, link.MemoID, MemoShareNamePrefix, link.ID),
+		Token:       link.Token,
+		CreateTime:  timestamppb.New(time.Unix(link.CreatedTs, 0)),
+		HasPassword: link.PasswordHash != "",
+	}
+	if link.ExpireTs != 0 {
+		share.ExpireTime = timestamppb.New(time.Unix(link.ExpireTs, 0))
+	}
+	return share
+}
@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"slices"
+	"strings"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// MemoTagSeparator separates the segments of hierarchical tags, e.g. "project/alpha/design".
+const MemoTagSeparator = "/"
+
+// isTagInSubtree returns true if the tag is the root or one of its descendants.
+func isTagInSubtree(tag, root string) bool {
+	return tag == root || strings.HasPrefix(tag, root+MemoTagSeparator)
+}
+
+// getTagAncestors returns the tag along with all of its ancestors, e.g. "a", "a/b" and "a/b/c" for "a/b/c".
+func getTagAncestors(tag string) []string {
+	segments := []string{}
+	for _, segment := range strings.Split(tag, MemoTagSeparator) {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	ancestors := []string{}
+	for i := range segments {
+		ancestors = append(ancestors, strings.Join(segments[:i+1], MemoTagSeparator))
+	}
+	return ancestors
+}
+
+// buildMemoTagTree builds the tag tree of the memos. Each memo is counted once per node,
+// even if it has several tags in the subtree of the node.
+func buildMemoTagTree(memos []*store.Memo) []*v1pb.MemoTagNode {
+	nodes := map[string]*v1pb.MemoTagNode{}
+	roots := []*v1pb.MemoTagNode{}
+	getNode := func(tag string) *v1pb.MemoTagNode {
+		if node, ok := nodes[tag]; ok {
+			return node
+		}
+		node := &v1pb.MemoTagNode{
+			Name:     tag[strings.LastIndex(tag, MemoTagSeparator)+1:],
+			Tag:      tag,
+			Children: []*v1pb.MemoTagNode{},
+		}
+		nodes[tag] = node
+		if index := strings.LastIndex(tag, MemoTagSeparator); index >= 0 {
+			parent := nodes[tag[:index]]
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+		return node
+	}
+
+	for _, memo := range memos {
+		if memo.Payload == nil || memo.Payload.Property == nil {
+			continue
+		}
+		counted := map[string]bool{}
+		for _, tag := range memo.Payload.Property.Tags {
+			ancestors := getTagAncestors(tag)
+			for _, ancestor := range ancestors {
+				node := getNode(ancestor)
+				if !counted[ancestor] {
+					node.TotalAmount++
+					counted[ancestor] = true
+				}
+			}
+			if len(ancestors) > 0 && ancestors[len(ancestors)-1] == tag {
+				nodes[tag].Amount++
+			}
+		}
+	}
+
+	sortMemoTagNodes(roots)
+	return roots
+}
+
+func sortMemoTagNodes(nodes []*v1pb.MemoTagNode) {
+	slices.SortFunc(nodes, func(a, b *v1pb.MemoTagNode) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	for _, node := range nodes {
+		sortMemoTagNodes(node.Children)
+	}
+}
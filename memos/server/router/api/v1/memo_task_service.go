@@ -0,0 +1,394 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/overloads"
+	"github.com/pkg/errors"
+	"github.com/usememos/gomark/ast"
+	"github.com/usememos/gomark/parser"
+	"github.com/usememos/gomark/parser/tokenizer"
+	"github.com/usememos/gomark/restore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// taskDueDateRegex matches the due date of a task item, e.g. "due:2024-06-01" or "📅 2024-06-01".
+var taskDueDateRegex = regexp.MustCompile(`(?:\bdue:|📅\s*)(\d{4}-\d{2}-\d{2})\b`)
+
+// ListTasksFilterCELAttributes are the CEL attributes of ListTasksRequest.
+var ListTasksFilterCELAttributes = []cel.EnvOption{
+	cel.Variable("completed", cel.BoolType),
+	cel.Variable("has_due_time", cel.BoolType),
+	cel.Variable("due_time", cel.IntType),
+	cel.Variable("content", cel.StringType),
+}
+
+func (s *APIV1Service) ListTasks(ctx context.Context, request *v1pb.ListTasksRequest) (*v1pb.ListTasksResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	normalRowStatus := store.Normal
+	taskFind := &store.FindMemoTask{
+		CreatorID:     &user.ID,
+		MemoRowStatus: &normalRowStatus,
+	}
+	if request.Parent != "memos/-" {
+		memoID, err := ExtractMemoIDFromName(request.Parent)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+		}
+		taskFind.MemoID = &memoID
+	}
+	if request.Filter != "" {
+		if err := parseListTasksFilter(taskFind, request.Filter); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+	}
+
+	tasks, err := s.Store.ListMemoTasks(ctx, taskFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tasks: %v", err)
+	}
+	response := &v1pb.ListTasksResponse{
+		Tasks: []*v1pb.Task{},
+	}
+	for _, task := range tasks {
+		response.Tasks = append(response.Tasks, convertMemoTaskFromStore(task))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) SetTaskCompleted(ctx context.Context, request *v1pb.SetTaskCompletedRequest) (*v1pb.Task, error) {
+	memoID, position, err := ExtractMemoTaskPositionFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	content, err := setTaskCompletedInContent(memo.Content, position, request.Completed)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "task not found: %v", err)
+	}
+	// The memo is updated as a whole, so that the revisions, webhooks and indexes follow the content.
+	// The update is checked against the version the task is set in, so that the edits made meanwhile are kept.
+	if content != memo.Content {
+		if _, err := s.UpdateMemo(ctx, &v1pb.UpdateMemoRequest{
+			Memo: &v1pb.Memo{
+				Name:    fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID),
+				Content: content,
+				Etag:    getMemoEtag(memo),
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	task, err := s.Store.GetMemoTask(ctx, &store.FindMemoTask{
+		MemoID:   &memo.ID,
+		Position: &position,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get task: %v", err)
+	}
+	if task == nil {
+		return nil, status.Errorf(codes.NotFound, "task not found")
+	}
+	return convertMemoTaskFromStore(task), nil
+}
+
+// syncMemoTasks replaces the indexed tasks of the memo with the task list items in its content.
+func syncMemoTasks(ctx context.Context, s *store.Store, memo *store.Memo) error {
+	tasks, err := getMemoTasksFromContent(memo.Content)
+	if err != nil {
+		return err
+	}
+	if err := syncMemoTaskReminders(ctx, s, memo.ID, tasks); err != nil {
+		return err
+	}
+	if err := s.DeleteMemoTask(ctx, &store.DeleteMemoTask{MemoID: memo.ID}); err != nil {
+		return errors.Wrap(err, "failed to delete memo tasks")
+	}
+	for _, task := range tasks {
+		task.MemoID = memo.ID
+		task.CreatorID = memo.CreatorID
+		if _, err := s.CreateMemoTask(ctx, task); err != nil {
+			return errors.Wrap(err, "failed to create memo task")
+		}
+	}
+	return nil
+}
+
+// syncMemoTaskReminders moves the reminders of the tasks of the memo to the positions of the same tasks in the new tasks.
+// Tasks are the same if their contents are, and the reminders of the tasks that are gone are deleted.
+func syncMemoTaskReminders(ctx context.Context, s *store.Store, memoID int32, tasks []*store.MemoTask) error {
+	hasTask := true
+	reminders, err := s.ListMemoReminders(ctx, &store.FindMemoReminder{
+		MemoID:  &memoID,
+		HasTask: &hasTask,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo task reminders")
+	}
+	if len(reminders) == 0 {
+		return nil
+	}
+	previousTasks, err := s.ListMemoTasks(ctx, &store.FindMemoTask{MemoID: &memoID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo tasks")
+	}
+
+	// Tasks that stay in place keep their positions, the others take the first free position of the same content.
+	positions, claimed := map[int32]int32{}, map[int32]bool{}
+	for _, task := range previousTasks {
+		if int(task.Position) < len(tasks) && tasks[task.Position].Content == task.Content {
+			positions[task.Position], claimed[task.Position] = task.Position, true
+		}
+	}
+	for _, task := range previousTasks {
+		if _, ok := positions[task.Position]; ok {
+			continue
+		}
+		for _, newTask := range tasks {
+			if !claimed[newTask.Position] && newTask.Content == task.Content {
+				positions[task.Position], claimed[newTask.Position] = newTask.Position, true
+				break
+			}
+		}
+	}
+
+	for _, reminder := range reminders {
+		position, ok := positions[*reminder.TaskPosition]
+		if !ok {
+			if err := s.DeleteMemoReminder(ctx, &store.DeleteMemoReminder{ID: &reminder.ID}); err != nil {
+				return errors.Wrap(err, "failed to delete memo task reminder")
+			}
+			continue
+		}
+		if position == *reminder.TaskPosition {
+			continue
+		}
+		if _, err := s.UpdateMemoReminder(ctx, &store.UpdateMemoReminder{
+			ID:           reminder.ID,
+			TaskPosition: &position,
+		}); err != nil {
+			return errors.Wrap(err, "failed to move memo task reminder")
+		}
+	}
+	return nil
+}
+
+// getMemoTasksFromContent returns the task list items of the content in order.
+func getMemoTasksFromContent(content string) ([]*store.MemoTask, error) {
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse content")
+	}
+
+	tasks := []*store.MemoTask{}
+	TraverseASTNodes(nodes, func(node ast.Node) {
+		n, ok := node.(*ast.TaskList)
+		if !ok {
+			return
+		}
+		text := strings.TrimSpace(restore.Restore(n.Children))
+		tasks = append(tasks, &store.MemoTask{
+			Position:  int32(len(tasks)),
+			Content:   text,
+			Completed: n.Complete,
+			DueTs:     getTaskDueTs(text),
+		})
+	})
+	return tasks, nil
+}
+
+// getTaskDueTs returns the due date of the task text as the unix time of its UTC midnight.
+func getTaskDueTs(text string) *int64 {
+	matches := taskDueDateRegex.FindStringSubmatch(text)
+	if len(matches) != 2 {
+		return nil
+	}
+	dueDate, err := time.Parse(time.DateOnly, matches[1])
+	if err != nil {
+		return nil
+	}
+	dueTs := dueDate.Unix()
+	return &dueTs
+}
+
+// setTaskCompletedInContent checks or unchecks the task list item at the position of the content.
+func setTaskCompletedInContent(content string, position int32, completed bool) (string, error) {
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse content")
+	}
+
+	var task *ast.TaskList
+	index := int32(0)
+	TraverseASTNodes(nodes, func(node ast.Node) {
+		n, ok := node.(*ast.TaskList)
+		if !ok {
+			return
+		}
+		if index == position {
+			task = n
+		}
+		index++
+	})
+	if task == nil {
+		return "", errors.Errorf("no task at position %d", position)
+	}
+	if task.Complete == completed {
+		return content, nil
+	}
+	task.Complete = completed
+	return restore.Restore(nodes), nil
+}
+
+// parseListTasksFilter sets the conditions of the filter to the find, which must be a chain of `&&`.
+func parseListTasksFilter(find *store.FindMemoTask, expression string) error {
+	e, err := cel.NewEnv(ListTasksFilterCELAttributes...)
+	if err != nil {
+		return err
+	}
+	ast, issues := e.Compile(expression)
+	if issues != nil {
+		return errors.Errorf("found issue %v", issues)
+	}
+	if ast.OutputType() != cel.BoolType {
+		return errors.Errorf("filter must be a boolean expression")
+	}
+	parsedExpr, err := cel.AstToParsedExpr(ast)
+	if err != nil {
+		return err
+	}
+
+	for _, conjunct := range flattenConjunction(parsedExpr.GetExpr()) {
+		// A boolean variable alone is the same as `name == true`.
+		if identExpr := conjunct.GetIdentExpr(); identExpr != nil {
+			v := true
+			switch identExpr.Name {
+			case "completed":
+				find.Completed = &v
+			case "has_due_time":
+				find.HasDue = &v
+			default:
+				return errors.Errorf("unsupported usage of %q", identExpr.Name)
+			}
+			continue
+		}
+		callExpr := conjunct.GetCallExpr()
+		if callExpr == nil {
+			return errors.Errorf("unsupported expression %v", conjunct)
+		}
+		if callExpr.Function == overloads.Contains {
+			if callExpr.GetTarget().GetIdentExpr().GetName() != "content" {
+				return errors.Errorf("%q is only supported on content", callExpr.Function)
+			}
+			value, err := getConstValue(callExpr.Args[0])
+			if err != nil {
+				return errors.Wrapf(err, "invalid value for %q", callExpr.Function)
+			}
+			search, _ := value.(string)
+			find.ContentSearch = append(find.ContentSearch, search)
+			continue
+		}
+		if _, ok := memoFilterComparisonOperators[callExpr.Function]; !ok || len(callExpr.Args) != 2 {
+			return errors.Errorf("unsupported operator %q", callExpr.Function)
+		}
+		name := callExpr.Args[0].GetIdentExpr().GetName()
+		if name == "" {
+			return errors.Errorf("the left side of a comparison must be a variable")
+		}
+		value, err := getConstValue(callExpr.Args[1])
+		if err != nil {
+			return errors.Wrapf(err, "invalid value for %q", name)
+		}
+		switch name {
+		case "completed", "has_due_time":
+			if callExpr.Function != operators.Equals && callExpr.Function != operators.NotEquals {
+				return errors.Errorf("%q only supports == and !=", name)
+			}
+			v, _ := value.(bool)
+			if callExpr.Function == operators.NotEquals {
+				v = !v
+			}
+			if name == "completed" {
+				find.Completed = &v
+			} else {
+				find.HasDue = &v
+			}
+		case "due_time":
+			v, _ := value.(int64)
+			before, after := v, v
+			switch callExpr.Function {
+			case operators.Less:
+				find.DueTsBefore = &before
+			case operators.LessEquals:
+				before++
+				find.DueTsBefore = &before
+			case operators.Greater:
+				find.DueTsAfter = &after
+			case operators.GreaterEquals:
+				after--
+				find.DueTsAfter = &after
+			case operators.Equals:
+				before, after = v+1, v-1
+				find.DueTsBefore, find.DueTsAfter = &before, &after
+			default:
+				return errors.Errorf("%q does not support !=", name)
+			}
+		default:
+			return errors.Errorf("unsupported usage of %q", name)
+		}
+	}
+	return nil
+}
+
+func convertMemoTaskFromStore(task *store.MemoTask) *v1pb.Task {
+	memoName := fmt.Sprintf("%s%d", MemoNamePrefix, task.MemoID)
+	taskMessage := &v1pb.Task{
+		Name:      fmt.Sprintf("%s/%s%d", memoName, MemoTaskNamePrefix, task.Position),
+		Memo:      memoName,
+		Position:  task.Position,
+		Content:   task.Content,
+		Completed: task.Completed,
+	}
+	if task.DueTs != nil {
+		taskMessage.DueTime = timestamppb.New(time.Unix(*task.DueTs, 0))
+	}
+	return taskMessage
+}
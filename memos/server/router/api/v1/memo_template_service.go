@@ -0,0 +1,329 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) CreateMemoTemplate(ctx context.Context, request *v1pb.CreateMemoTemplateRequest) (*v1pb.MemoTemplate, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if request.Template == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "template is required")
+	}
+	if request.Template.Title == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "title is required")
+	}
+	scope := convertMemoTemplateScopeToStore(request.Template.Scope)
+	if scope == store.MemoTemplateScopeWorkspace && user.Role != store.RoleHost && user.Role != store.RoleAdmin {
+		return nil, status.Errorf(codes.PermissionDenied, "only admins can create workspace templates")
+	}
+	tags, err := normalizeMemoTemplateTags(request.Template.Tags)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tags: %v", err)
+	}
+
+	template, err := s.Store.CreateMemoTemplate(ctx, &store.MemoTemplate{
+		CreatorID:  user.ID,
+		Scope:      scope,
+		Title:      request.Template.Title,
+		Content:    request.Template.Content,
+		Visibility: convertVisibilityToStore(request.Template.Visibility),
+		Payload:    &storepb.MemoTemplatePayload{Tags: tags},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo template: %v", err)
+	}
+	return convertMemoTemplateFromStore(template), nil
+}
+
+func (s *APIV1Service) ListMemoTemplates(ctx context.Context, _ *v1pb.ListMemoTemplatesRequest) (*v1pb.ListMemoTemplatesResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	userScope, workspaceScope := store.MemoTemplateScopeUser, store.MemoTemplateScopeWorkspace
+	userTemplates, err := s.Store.ListMemoTemplates(ctx, &store.FindMemoTemplate{
+		CreatorID: &user.ID,
+		Scope:     &userScope,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo templates: %v", err)
+	}
+	workspaceTemplates, err := s.Store.ListMemoTemplates(ctx, &store.FindMemoTemplate{
+		Scope: &workspaceScope,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo templates: %v", err)
+	}
+
+	response := &v1pb.ListMemoTemplatesResponse{
+		Templates: []*v1pb.MemoTemplate{},
+	}
+	for _, template := range append(userTemplates, workspaceTemplates...) {
+		response.Templates = append(response.Templates, convertMemoTemplateFromStore(template))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetMemoTemplate(ctx context.Context, request *v1pb.GetMemoTemplateRequest) (*v1pb.MemoTemplate, error) {
+	template, err := s.getAvailableMemoTemplate(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return convertMemoTemplateFromStore(template), nil
+}
+
+func (s *APIV1Service) UpdateMemoTemplate(ctx context.Context, request *v1pb.UpdateMemoTemplateRequest) (*v1pb.MemoTemplate, error) {
+	if request.Template == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "template is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	template, err := s.getManageableMemoTemplate(ctx, request.Template.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedTs := time.Now().Unix()
+	update := &store.UpdateMemoTemplate{
+		ID:        template.ID,
+		UpdatedTs: &updatedTs,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "title":
+			if request.Template.Title == "" {
+				return nil, status.Errorf(codes.InvalidArgument, "title is required")
+			}
+			update.Title = &request.Template.Title
+		case "content":
+			update.Content = &request.Template.Content
+		case "visibility":
+			visibility := convertVisibilityToStore(request.Template.Visibility)
+			update.Visibility = &visibility
+		case "tags":
+			tags, err := normalizeMemoTemplateTags(request.Template.Tags)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid tags: %v", err)
+			}
+			payload := template.Payload
+			payload.Tags = tags
+			update.Payload = payload
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported update path %q", path)
+		}
+	}
+
+	template, err = s.Store.UpdateMemoTemplate(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update memo template: %v", err)
+	}
+	return convertMemoTemplateFromStore(template), nil
+}
+
+func (s *APIV1Service) DeleteMemoTemplate(ctx context.Context, request *v1pb.DeleteMemoTemplateRequest) (*emptypb.Empty, error) {
+	template, err := s.getManageableMemoTemplate(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteMemoTemplate(ctx, &store.DeleteMemoTemplate{ID: template.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memo template: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getAvailableMemoTemplate returns the template with the given name, which must be owned by the current user
+// or shared with the workspace.
+func (s *APIV1Service) getAvailableMemoTemplate(ctx context.Context, name string) (*store.MemoTemplate, error) {
+	templateID, err := ExtractMemoTemplateIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo template name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	template, err := s.Store.GetMemoTemplate(ctx, &store.FindMemoTemplate{ID: &templateID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo template: %v", err)
+	}
+	// Templates of other users are reported as missing.
+	if template == nil || (template.Scope == store.MemoTemplateScopeUser && template.CreatorID != user.ID) {
+		return nil, status.Errorf(codes.NotFound, "memo template not found")
+	}
+	return template, nil
+}
+
+// getManageableMemoTemplate returns the template with the given name, which the current user is allowed to change.
+// Workspace templates are managed by admins.
+func (s *APIV1Service) getManageableMemoTemplate(ctx context.Context, name string) (*store.MemoTemplate, error) {
+	template, err := s.getAvailableMemoTemplate(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if template.Scope == store.MemoTemplateScopeWorkspace {
+		user, err := s.GetCurrentUser(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		}
+		if user.Role != store.RoleHost && user.Role != store.RoleAdmin {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
+	return template, nil
+}
+
+// createMemoOnBehalf creates a memo of the user from the template if any, so that it goes through the same checks
+// and webhooks as the memos created by the user. The template variables are expanded at the local time.
+// The writes of beforeCreate, if any, are committed along with the memo.
+func (s *APIV1Service) createMemoOnBehalf(ctx context.Context, user *store.User, templateID int32, content string, visibility v1pb.Visibility, localNow time.Time, beforeCreate func(txStore *store.Store) error) (*v1pb.Memo, error) {
+	userCtx := context.WithValue(ctx, usernameContextKey, user.Username)
+	if templateID != 0 {
+		template, err := s.getAvailableMemoTemplate(userCtx, fmt.Sprintf("%s%d", MemoTemplateNamePrefix, templateID))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get memo template")
+		}
+		content, err = instantiateMemoTemplate(template, user, content, localNow)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to instantiate memo template")
+		}
+		if visibility == v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+			visibility = convertVisibilityFromStore(template.Visibility)
+		}
+	}
+	return s.createMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:    content,
+		Visibility: visibility,
+	}, beforeCreate)
+}
+
+// getUserLocation returns the location of the timezone setting of the user, which defaults to UTC.
+func (s *APIV1Service) getUserLocation(ctx context.Context, user *store.User) (*time.Location, error) {
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_TIMEZONE,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user setting")
+	}
+	location, err := time.LoadLocation(userSetting.GetTimezone())
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid timezone %q", userSetting.GetTimezone())
+	}
+	return location, nil
+}
+
+// instantiateMemoTemplate returns the content of a memo created from the template by the user,
+// with the variables expanded, the content appended and the missing template tags attached.
+func instantiateMemoTemplate(template *store.MemoTemplate, user *store.User, content string, now time.Time) (string, error) {
+	userName := user.Nickname
+	if userName == "" {
+		userName = user.Username
+	}
+	result := strings.NewReplacer(
+		"{{date}}", now.Format(time.DateOnly),
+		"{{time}}", now.Format("15:04"),
+		"{{weekday}}", now.Weekday().String(),
+		"{{user}}", userName,
+	).Replace(template.Content)
+	if content != "" {
+		if result != "" {
+			result += "\n\n"
+		}
+		result += content
+	}
+
+	property, err := getMemoPropertyFromContent(result)
+	if err != nil {
+		return "", err
+	}
+	missingTags := []string{}
+	for _, tag := range template.Payload.GetTags() {
+		if !slices.Contains(property.Tags, tag) {
+			missingTags = append(missingTags, "#"+tag)
+		}
+	}
+	if len(missingTags) > 0 {
+		if result != "" {
+			result += "\n\n"
+		}
+		result += strings.Join(missingTags, " ")
+	}
+	return result, nil
+}
+
+// normalizeMemoTemplateTags trims the leading hash of the tags, which must not contain spaces.
+func normalizeMemoTemplateTags(tags []string) ([]string, error) {
+	list := []string{}
+	for _, tag := range tags {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "#")
+		if tag == "" || strings.ContainsAny(tag, " \t\n") {
+			return nil, errors.Errorf("invalid tag %q", tag)
+		}
+		if !slices.Contains(list, tag) {
+			list = append(list, tag)
+		}
+	}
+	return list, nil
+}
+
+func convertMemoTemplateFromStore(template *store.MemoTemplate) *v1pb.MemoTemplate {
+	return &v1pb.MemoTemplate{
+		Name:       fmt.Sprintf("%s%d", MemoTemplateNamePrefix, template.ID),
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, template.CreatorID),
+		Scope:      convertMemoTemplateScopeFromStore(template.Scope),
+		Title:      template.Title,
+		Content:    template.Content,
+		Tags:       template.Payload.GetTags(),
+		Visibility: convertVisibilityFromStore(template.Visibility),
+		CreateTime: timestamppb.New(time.Unix(template.CreatedTs, 0)),
+		UpdateTime: timestamppb.New(time.Unix(template.UpdatedTs, 0)),
+	}
+}
+
+func convertMemoTemplateScopeFromStore(scope store.MemoTemplateScope) v1pb.MemoTemplate_Scope {
+	switch scope {
+	case store.MemoTemplateScopeUser:
+		return v1pb.MemoTemplate_USER
+	case store.MemoTemplateScopeWorkspace:
+		return v1pb.MemoTemplate_WORKSPACE
+	default:
+		return v1pb.MemoTemplate_SCOPE_UNSPECIFIED
+	}
+}
+
+func convertMemoTemplateScopeToStore(scope v1pb.MemoTemplate_Scope) store.MemoTemplateScope {
+	switch scope {
+	case v1pb.MemoTemplate_WORKSPACE:
+		return store.MemoTemplateScopeWorkspace
+	default:
+		return store.MemoTemplateScopeUser
+	}
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV1Service) ListTrashedMemos(ctx context.Context, request *v1pb.ListTrashedMemosRequest) (*v1pb.ListTrashedMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user")
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	memoFind := &store.FindMemo{
+		CreatorID: &user.ID,
+		Trashed:   true,
+	}
+	limit, offset, cursor, err := parsePageToken(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	memoFind.Limit = &limitPlusOne
+	if cursor != nil {
+		memoFind.Cursor = convertPageCursorToMemoCursor(cursor)
+	} else {
+		memoFind.Offset = &offset
+	}
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list trashed memos: %v", err)
+	}
+
+	response := &v1pb.ListTrashedMemosResponse{}
+	if len(memos) == limitPlusOne {
+		memos = memos[:limit]
+		nextPageToken, err := getCursorPageToken(limit, getMemoPageCursor(memoFind, memos[len(memos)-1]))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+		response.NextPageToken = nextPageToken
+	}
+	response.Memos, err = s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) RestoreMemo(ctx context.Context, request *v1pb.RestoreMemoRequest) (*v1pb.Memo, error) {
+	id, err := ExtractMemoIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.getMemoOwnedByCurrentUser(ctx, &store.FindMemo{ID: &id, Trashed: true})
+	if err != nil {
+		return nil, err
+	}
+	// A comment can only be restored when its memo is not in the trash bin.
+	if memo.ParentID != nil {
+		parent, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: memo.ParentID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get parent memo")
+		}
+		if parent == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "the parent memo is in the trash bin")
+		}
+	}
+
+	if err := s.Store.RestoreMemo(ctx, id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to restore memo: %v", err)
+	}
+	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	memoMessage, err := s.convertMemoFromStore(ctx, memo)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memo")
+	}
+	return memoMessage, nil
+}
+
+func (s *APIV1Service) PurgeMemo(ctx context.Context, request *v1pb.PurgeMemoRequest) (*emptypb.Empty, error) {
+	id, err := ExtractMemoIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	if _, err := s.getMemoOwnedByCurrentUser(ctx, &store.FindMemo{ID: &id, Trashed: true}); err != nil {
+		return nil, err
+	}
+
+	if err := s.Store.PurgeMemo(ctx, id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to purge memo: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
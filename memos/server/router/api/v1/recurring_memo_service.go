@@ -0,0 +1,414 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/cron"
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// DefaultRecurringMemoPreviewCount is the number of run times previewed if not requested.
+	DefaultRecurringMemoPreviewCount = 5
+	// MaxRecurringMemoPreviewCount is the maximum number of run times previewed at once.
+	MaxRecurringMemoPreviewCount = 50
+)
+
+func (s *APIV1Service) CreateRecurringMemo(ctx context.Context, request *v1pb.CreateRecurringMemoRequest) (*v1pb.RecurringMemo, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if request.RecurringMemo == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "recurring memo is required")
+	}
+	timezone := request.RecurringMemo.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	if _, _, err := parseRecurringMemoSchedule(request.RecurringMemo.CronExpression, timezone); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schedule: %v", err)
+	}
+
+	payload := &storepb.RecurringMemoPayload{
+		Content: request.RecurringMemo.Content,
+	}
+	if request.RecurringMemo.Memo != "" {
+		memo, err := s.getCurrentUserMemo(ctx, request.RecurringMemo.Memo)
+		if err != nil {
+			return nil, err
+		}
+		payload.RemindMemoId = memo.ID
+	} else {
+		if request.RecurringMemo.Template != "" {
+			template, err := s.getAvailableMemoTemplate(ctx, request.RecurringMemo.Template)
+			if err != nil {
+				return nil, err
+			}
+			payload.TemplateId = template.ID
+		} else if strings.TrimSpace(request.RecurringMemo.Content) == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "template or content is required")
+		}
+		if request.RecurringMemo.Visibility != v1pb.Visibility_VISIBILITY_UNSPECIFIED {
+			visibility := convertVisibilityToStore(request.RecurringMemo.Visibility)
+			workspaceMemoRelatedSetting, err := s.Store.GetWorkspaceMemoRelatedSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get workspace memo related setting")
+			}
+			if workspaceMemoRelatedSetting.DisallowPublicVisible && visibility == store.Public {
+				return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
+			}
+			payload.Visibility = visibility.String()
+		}
+	}
+
+	recurringMemo, err := s.Store.CreateRecurringMemo(ctx, &store.RecurringMemo{
+		CreatorID:      user.ID,
+		State:          store.RecurringMemoStateActive,
+		CronExpression: request.RecurringMemo.CronExpression,
+		Timezone:       timezone,
+		Payload:        payload,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create recurring memo: %v", err)
+	}
+	return convertRecurringMemoFromStore(recurringMemo), nil
+}
+
+func (s *APIV1Service) ListRecurringMemos(ctx context.Context, _ *v1pb.ListRecurringMemosRequest) (*v1pb.ListRecurringMemosResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	recurringMemos, err := s.Store.ListRecurringMemos(ctx, &store.FindRecurringMemo{
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list recurring memos: %v", err)
+	}
+	response := &v1pb.ListRecurringMemosResponse{
+		RecurringMemos: []*v1pb.RecurringMemo{},
+	}
+	for _, recurringMemo := range recurringMemos {
+		response.RecurringMemos = append(response.RecurringMemos, convertRecurringMemoFromStore(recurringMemo))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) PauseRecurringMemo(ctx context.Context, request *v1pb.PauseRecurringMemoRequest) (*v1pb.RecurringMemo, error) {
+	recurringMemo, err := s.getCurrentUserRecurringMemo(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	updatedTs, state := time.Now().Unix(), store.RecurringMemoStatePaused
+	recurringMemo, err = s.Store.UpdateRecurringMemo(ctx, &store.UpdateRecurringMemo{
+		ID:        recurringMemo.ID,
+		UpdatedTs: &updatedTs,
+		State:     &state,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to pause recurring memo: %v", err)
+	}
+	return convertRecurringMemoFromStore(recurringMemo), nil
+}
+
+func (s *APIV1Service) ResumeRecurringMemo(ctx context.Context, request *v1pb.ResumeRecurringMemoRequest) (*v1pb.RecurringMemo, error) {
+	recurringMemo, err := s.getCurrentUserRecurringMemo(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if recurringMemo.State == store.RecurringMemoStateActive {
+		return convertRecurringMemoFromStore(recurringMemo), nil
+	}
+	// The runs missed while paused are skipped by scheduling from now on.
+	now, state := time.Now().Unix(), store.RecurringMemoStateActive
+	recurringMemo, err = s.Store.UpdateRecurringMemo(ctx, &store.UpdateRecurringMemo{
+		ID:        recurringMemo.ID,
+		UpdatedTs: &now,
+		State:     &state,
+		LastRunTs: &now,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to resume recurring memo: %v", err)
+	}
+	return convertRecurringMemoFromStore(recurringMemo), nil
+}
+
+func (s *APIV1Service) DeleteRecurringMemo(ctx context.Context, request *v1pb.DeleteRecurringMemoRequest) (*emptypb.Empty, error) {
+	recurringMemo, err := s.getCurrentUserRecurringMemo(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteRecurringMemo(ctx, &store.DeleteRecurringMemo{ID: recurringMemo.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete recurring memo: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (*APIV1Service) PreviewRecurringMemoSchedule(_ context.Context, request *v1pb.PreviewRecurringMemoScheduleRequest) (*v1pb.PreviewRecurringMemoScheduleResponse, error) {
+	timezone := request.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	schedule, location, err := parseRecurringMemoSchedule(request.CronExpression, timezone)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid schedule: %v", err)
+	}
+	count := int(request.Count)
+	if count <= 0 {
+		count = DefaultRecurringMemoPreviewCount
+	}
+	if count > MaxRecurringMemoPreviewCount {
+		count = MaxRecurringMemoPreviewCount
+	}
+
+	response := &v1pb.PreviewRecurringMemoScheduleResponse{
+		RunTimes: []*timestamppb.Timestamp{},
+	}
+	next := time.Now().In(location)
+	for len(response.RunTimes) < count {
+		next = schedule.Next(next)
+		if next.IsZero() {
+			break
+		}
+		response.RunTimes = append(response.RunTimes, timestamppb.New(next))
+	}
+	return response, nil
+}
+
+// RunDueRecurringMemos runs the active recurring memos whose next run time has come.
+// A recurring memo runs once even if several run times were missed.
+func (s *APIV1Service) RunDueRecurringMemos(ctx context.Context, now time.Time) error {
+	state := store.RecurringMemoStateActive
+	recurringMemos, err := s.Store.ListRecurringMemos(ctx, &store.FindRecurringMemo{
+		State: &state,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list recurring memos")
+	}
+	for _, recurringMemo := range recurringMemos {
+		if err := s.runDueRecurringMemo(ctx, recurringMemo, now); err != nil {
+			slog.Warn("Failed to run recurring memo", slog.Int("id", int(recurringMemo.ID)), slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+func (s *APIV1Service) runDueRecurringMemo(ctx context.Context, recurringMemo *store.RecurringMemo, now time.Time) error {
+	schedule, location, err := parseRecurringMemoSchedule(recurringMemo.CronExpression, recurringMemo.Timezone)
+	if err != nil {
+		return err
+	}
+	next := schedule.Next(getRecurringMemoScheduledFrom(recurringMemo).In(location))
+	if next.IsZero() || next.After(now) {
+		return nil
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &recurringMemo.CreatorID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user")
+	}
+	if user == nil || user.RowStatus == store.Archived {
+		return nil
+	}
+	// The run is claimed in the same transaction as its memo or reminder, so that it is done once even if several servers run it.
+	alreadyRun := false
+	claim := func(txStore *store.Store) error {
+		err := claimRecurringMemoRun(ctx, txStore, recurringMemo, now)
+		alreadyRun = errors.Is(err, store.ErrRecurringMemoChanged)
+		return err
+	}
+	if recurringMemo.Payload.RemindMemoId != 0 {
+		err = s.sendRecurringMemoReminder(ctx, recurringMemo, claim)
+	} else {
+		visibility := v1pb.Visibility_VISIBILITY_UNSPECIFIED
+		if recurringMemo.Payload.Visibility != "" {
+			visibility = convertVisibilityFromStore(store.Visibility(recurringMemo.Payload.Visibility))
+		}
+		_, err = s.createMemoOnBehalf(ctx, user, recurringMemo.Payload.TemplateId, recurringMemo.Payload.Content, visibility, now.In(location), claim)
+		err = errors.Wrap(err, "failed to create memo")
+	}
+	if err == nil || alreadyRun {
+		return nil
+	}
+	// The failing run is still recorded, so that it is not retried every minute.
+	if err := claimRecurringMemoRun(ctx, s.Store, recurringMemo, now); err != nil && !errors.Is(err, store.ErrRecurringMemoChanged) {
+		slog.Warn("Failed to record recurring memo run", slog.Int("id", int(recurringMemo.ID)), slog.Any("err", err))
+	}
+	return err
+}
+
+// claimRecurringMemoRun records the run of the recurring memo at now.
+// ErrRecurringMemoChanged is returned if the recurring memo has run since it was read.
+func claimRecurringMemoRun(ctx context.Context, s *store.Store, recurringMemo *store.RecurringMemo, now time.Time) error {
+	lastRunTs, expectedLastRunTs := now.Unix(), recurringMemo.LastRunTs
+	_, err := s.UpdateRecurringMemo(ctx, &store.UpdateRecurringMemo{
+		ID:                recurringMemo.ID,
+		LastRunTs:         &lastRunTs,
+		ExpectedLastRunTs: &expectedLastRunTs,
+	})
+	return err
+}
+
+// sendRecurringMemoReminder sends an inbox reminder of the memo of the recurring memo to its creator.
+// The run is claimed along with the reminder.
+func (s *APIV1Service) sendRecurringMemoReminder(ctx context.Context, recurringMemo *store.RecurringMemo, claim func(txStore *store.Store) error) error {
+	// The content is sent along with the reminder webhook.
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		ID: &recurringMemo.Payload.RemindMemoId,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	// There is nothing to remind of if the memo is gone or put away.
+	if memo == nil || memo.RowStatus != store.Normal || memo.DeletedTs != 0 {
+		return claim(s.Store)
+	}
+
+	if err := s.Store.WithTx(ctx, func(txStore *store.Store) error {
+		if err := claim(txStore); err != nil {
+			return err
+		}
+		activity, err := txStore.CreateActivity(ctx, &store.Activity{
+			CreatorID: store.SystemBotID,
+			Type:      store.ActivityTypeReminder,
+			Level:     store.ActivityLevelInfo,
+			Payload: &storepb.ActivityPayload{
+				Reminder: &storepb.ActivityReminderPayload{
+					MemoId:          memo.ID,
+					RecurringMemoId: recurringMemo.ID,
+				},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create activity")
+		}
+		if _, err := txStore.CreateInbox(ctx, &store.Inbox{
+			SenderID:   store.SystemBotID,
+			ReceiverID: recurringMemo.CreatorID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:       storepb.InboxMessage_REMINDER,
+				ActivityId: &activity.ID,
+			},
+		}); err != nil {
+			return errors.Wrap(err, "failed to create inbox")
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := s.DispatchMemoReminderWebhook(ctx, memo); err != nil {
+		slog.Warn("Failed to dispatch memo reminder webhook", slog.Any("err", err))
+	}
+	return nil
+}
+
+// getCurrentUserRecurringMemo returns the recurring memo with the given name, which must be owned by the current user.
+func (s *APIV1Service) getCurrentUserRecurringMemo(ctx context.Context, name string) (*store.RecurringMemo, error) {
+	recurringMemoID, err := ExtractRecurringMemoIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid recurring memo name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	recurringMemo, err := s.Store.GetRecurringMemo(ctx, &store.FindRecurringMemo{
+		ID:        &recurringMemoID,
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get recurring memo: %v", err)
+	}
+	if recurringMemo == nil {
+		return nil, status.Errorf(codes.NotFound, "recurring memo not found")
+	}
+	return recurringMemo, nil
+}
+
+// parseRecurringMemoSchedule parses the cron expression and the timezone it is evaluated in.
+func parseRecurringMemoSchedule(cronExpression, timezone string) (*cron.Schedule, *time.Location, error) {
+	schedule, err := cron.NewSchedule(cronExpression)
+	if err != nil {
+		return nil, nil, err
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, nil, errors.Errorf("invalid timezone %q", timezone)
+	}
+	return schedule, location, nil
+}
+
+// getRecurringMemoScheduledFrom returns the time that the next run of the recurring memo is scheduled from.
+func getRecurringMemoScheduledFrom(recurringMemo *store.RecurringMemo) time.Time {
+	if recurringMemo.LastRunTs != 0 {
+		return time.Unix(recurringMemo.LastRunTs, 0)
+	}
+	return time.Unix(recurringMemo.CreatedTs, 0)
+}
+
+func convertRecurringMemoFromStore(recurringMemo *store.RecurringMemo) *v1pb.RecurringMemo {
+	recurringMemoMessage := &v1pb.RecurringMemo{
+		Name:           fmt.Sprintf("%s%d", RecurringMemoNamePrefix, recurringMemo.ID),
+		Creator:        fmt.Sprintf("%s%d", UserNamePrefix, recurringMemo.CreatorID),
+		CronExpression: recurringMemo.CronExpression,
+		Timezone:       recurringMemo.Timezone,
+		Content:        recurringMemo.Payload.GetContent(),
+		State:          convertRecurringMemoStateFromStore(recurringMemo.State),
+		CreateTime:     timestamppb.New(time.Unix(recurringMemo.CreatedTs, 0)),
+	}
+	if recurringMemo.Payload.GetTemplateId() != 0 {
+		recurringMemoMessage.Template = fmt.Sprintf("%s%d", MemoTemplateNamePrefix, recurringMemo.Payload.TemplateId)
+	}
+	if recurringMemo.Payload.GetVisibility() != "" {
+		recurringMemoMessage.Visibility = convertVisibilityFromStore(store.Visibility(recurringMemo.Payload.Visibility))
+	}
+	if recurringMemo.Payload.GetRemindMemoId() != 0 {
+		recurringMemoMessage.Memo = fmt.Sprintf("%s%d", MemoNamePrefix, recurringMemo.Payload.RemindMemoId)
+	}
+	if recurringMemo.LastRunTs != 0 {
+		recurringMemoMessage.LastRunTime = timestamppb.New(time.Unix(recurringMemo.LastRunTs, 0))
+	}
+	if recurringMemo.State == store.RecurringMemoStateActive {
+		if schedule, location, err := parseRecurringMemoSchedule(recurringMemo.CronExpression, recurringMemo.Timezone); err == nil {
+			if next := schedule.Next(getRecurringMemoScheduledFrom(recurringMemo).In(location)); !next.IsZero() {
+				recurringMemoMessage.NextRunTime = timestamppb.New(next)
+			}
+		}
+	}
+	return recurringMemoMessage
+}
+
+func convertRecurringMemoStateFromStore(state store.RecurringMemoState) v1pb.RecurringMemo_State {
+	switch state {
+	case store.RecurringMemoStateActive:
+		return v1pb.RecurringMemo_ACTIVE
+	case store.RecurringMemoStatePaused:
+		return v1pb.RecurringMemo_PAUSED
+	default:
+		return v1pb.RecurringMemo_STATE_UNSPECIFIED
+	}
+}
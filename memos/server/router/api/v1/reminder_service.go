@@ -0,0 +1,221 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// DefaultReminderSnoozeDuration is the duration a reminder is postponed by if not requested.
+const DefaultReminderSnoozeDuration = 10 * time.Minute
+
+func (s *APIV1Service) SetReminder(ctx context.Context, request *v1pb.SetReminderRequest) (*v1pb.Reminder, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if request.Reminder == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "reminder is required")
+	}
+	if request.Reminder.RemindTime == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "remind time is required")
+	}
+	memoID, err := ExtractMemoIDFromName(request.Reminder.Memo)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		ID:             &memoID,
+		ExcludeContent: true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	pending := true
+	reminderFind := &store.FindMemoReminder{
+		MemoID:    &memo.ID,
+		CreatorID: &user.ID,
+		Pending:   &pending,
+	}
+	if request.Reminder.TaskPosition != nil {
+		task, err := s.Store.GetMemoTask(ctx, &store.FindMemoTask{
+			MemoID:   &memo.ID,
+			Position: request.Reminder.TaskPosition,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get task: %v", err)
+		}
+		if task == nil {
+			return nil, status.Errorf(codes.NotFound, "task not found")
+		}
+		reminderFind.TaskPosition = request.Reminder.TaskPosition
+	} else {
+		hasTask := false
+		reminderFind.HasTask = &hasTask
+	}
+	existing, err := s.Store.GetMemoReminder(ctx, reminderFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get reminder: %v", err)
+	}
+
+	remindTs := request.Reminder.RemindTime.AsTime().Unix()
+	var reminder *store.MemoReminder
+	if existing != nil {
+		reminder, err = s.Store.UpdateMemoReminder(ctx, &store.UpdateMemoReminder{
+			ID:       existing.ID,
+			RemindTs: &remindTs,
+		})
+	} else {
+		reminder, err = s.Store.CreateMemoReminder(ctx, &store.MemoReminder{
+			MemoID:       memo.ID,
+			CreatorID:    user.ID,
+			TaskPosition: request.Reminder.TaskPosition,
+			RemindTs:     remindTs,
+		})
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set reminder: %v", err)
+	}
+	return convertMemoReminderFromStore(reminder), nil
+}
+
+func (s *APIV1Service) ListReminders(ctx context.Context, request *v1pb.ListRemindersRequest) (*v1pb.ListRemindersResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	reminderFind := &store.FindMemoReminder{
+		CreatorID: &user.ID,
+	}
+	if request.Memo != "" {
+		memoID, err := ExtractMemoIDFromName(request.Memo)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+		}
+		reminderFind.MemoID = &memoID
+	}
+	if !request.IncludeFired {
+		pending := true
+		reminderFind.Pending = &pending
+	}
+	reminders, err := s.Store.ListMemoReminders(ctx, reminderFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list reminders: %v", err)
+	}
+	response := &v1pb.ListRemindersResponse{
+		Reminders: []*v1pb.Reminder{},
+	}
+	for _, reminder := range reminders {
+		response.Reminders = append(response.Reminders, convertMemoReminderFromStore(reminder))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) SnoozeReminder(ctx context.Context, request *v1pb.SnoozeReminderRequest) (*v1pb.Reminder, error) {
+	reminder, err := s.getCurrentUserReminder(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	duration := DefaultReminderSnoozeDuration
+	if request.Duration != nil {
+		duration = request.Duration.AsDuration()
+	}
+	if duration <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "duration must be positive")
+	}
+
+	remindTs, firedTs := time.Now().Add(duration).Unix(), int64(0)
+	reminder, err = s.Store.UpdateMemoReminder(ctx, &store.UpdateMemoReminder{
+		ID:       reminder.ID,
+		RemindTs: &remindTs,
+		FiredTs:  &firedTs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to snooze reminder: %v", err)
+	}
+	return convertMemoReminderFromStore(reminder), nil
+}
+
+func (s *APIV1Service) DeleteReminder(ctx context.Context, request *v1pb.DeleteReminderRequest) (*emptypb.Empty, error) {
+	reminder, err := s.getCurrentUserReminder(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteMemoReminder(ctx, &store.DeleteMemoReminder{
+		ID: &reminder.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete reminder: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DispatchMemoReminderWebhook dispatches webhook when a reminder of the memo fires.
+func (s *APIV1Service) DispatchMemoReminderWebhook(ctx context.Context, memo *store.Memo) error {
+	memoMessage, err := s.convertMemoFromStore(ctx, memo)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memo")
+	}
+	return s.dispatchMemoRelatedWebhook(ctx, memoMessage, "memos.memo.reminded")
+}
+
+// getCurrentUserReminder returns the reminder with the given name, which must be owned by the current user.
+func (s *APIV1Service) getCurrentUserReminder(ctx context.Context, name string) (*store.MemoReminder, error) {
+	reminderID, err := ExtractReminderIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reminder name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	reminder, err := s.Store.GetMemoReminder(ctx, &store.FindMemoReminder{
+		ID:        &reminderID,
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get reminder: %v", err)
+	}
+	if reminder == nil {
+		return nil, status.Errorf(codes.NotFound, "reminder not found")
+	}
+	return reminder, nil
+}
+
+func convertMemoReminderFromStore(reminder *store.MemoReminder) *v1pb.Reminder {
+	reminderMessage := &v1pb.Reminder{
+		Name:         fmt.Sprintf("%s%d", ReminderNamePrefix, reminder.ID),
+		Memo:         fmt.Sprintf("%s%d", MemoNamePrefix, reminder.MemoID),
+		TaskPosition: reminder.TaskPosition,
+		RemindTime:   timestamppb.New(time.Unix(reminder.RemindTs, 0)),
+	}
+	if reminder.FiredTs != 0 {
+		reminderMessage.FireTime = timestamppb.New(time.Unix(reminder.FiredTs, 0))
+	}
+	return reminderMessage
+}
@@ -13,10 +13,19 @@ const (
 	WorkspaceSettingNamePrefix = "settings/"
 	UserNamePrefix             = "users/"
 	MemoNamePrefix             = "memos/"
+	MemoRevisionNamePrefix     = "revisions/"
+	MemoTaskNamePrefix         = "tasks/"
+	MemoShareNamePrefix        = "shares/"
+	MemoCollaboratorNamePrefix = "collaborators/"
 	ResourceNamePrefix         = "resources/"
 	InboxNamePrefix            = "inboxes/"
 	StorageNamePrefix          = "storages/"
 	IdentityProviderNamePrefix = "identityProviders/"
+	TagNamePrefix              = "tags/"
+	ReminderNamePrefix         = "reminders/"
+	MemoTemplateNamePrefix     = "memoTemplates/"
+	RecurringMemoNamePrefix    = "recurringMemos/"
+	CollectionNamePrefix       = "collections/"
 )
 
 // GetNameParentTokens returns the tokens from a resource name.
@@ -73,6 +82,74 @@ func ExtractMemoIDFromName(name string) (int32, error) {
 	return id, nil
 }
 
+// ExtractMemoRevisionIDFromName returns the memo ID and revision ID from a memo revision name.
+func ExtractMemoRevisionIDFromName(name string) (int32, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoRevisionNamePrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	memoID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid memo ID %q", tokens[0])
+	}
+	revisionID, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid memo revision ID %q", tokens[1])
+	}
+	return memoID, revisionID, nil
+}
+
+// ExtractMemoTaskPositionFromName returns the memo ID and task position from a memo task name.
+func ExtractMemoTaskPositionFromName(name string) (int32, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoTaskNamePrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	memoID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid memo ID %q", tokens[0])
+	}
+	position, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil || position < 0 {
+		return 0, 0, errors.Errorf("invalid memo task position %q", tokens[1])
+	}
+	return memoID, position, nil
+}
+
+// ExtractMemoShareIDFromName returns the memo ID and share link ID from a memo share name.
+func ExtractMemoShareIDFromName(name string) (int32, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoShareNamePrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	memoID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid memo ID %q", tokens[0])
+	}
+	shareID, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid memo share ID %q", tokens[1])
+	}
+	return memoID, shareID, nil
+}
+
+// ExtractMemoCollaboratorFromName returns the memo ID and user ID from a memo collaborator name.
+func ExtractMemoCollaboratorFromName(name string) (int32, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, MemoCollaboratorNamePrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	memoID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid memo ID %q", tokens[0])
+	}
+	userID, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid user ID %q", tokens[1])
+	}
+	return memoID, userID, nil
+}
+
 // ExtractResourceIDFromName returns the resource ID from a resource name.
 func ExtractResourceIDFromName(name string) (int32, error) {
 	tokens, err := GetNameParentTokens(name, ResourceNamePrefix)
@@ -123,3 +200,68 @@ func ExtractIdentityProviderIDFromName(name string) (int32, error) {
 	}
 	return id, nil
 }
+
+// ExtractTagIDFromName returns the tag ID from a resource name.
+func ExtractTagIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, TagNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid tag ID %q", tokens[0])
+	}
+	return id, nil
+}
+
+// ExtractReminderIDFromName returns the reminder ID from a reminder name.
+func ExtractReminderIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, ReminderNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid reminder ID %q", tokens[0])
+	}
+	return id, nil
+}
+
+// ExtractMemoTemplateIDFromName returns the memo template ID from a memo template name.
+func ExtractMemoTemplateIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoTemplateNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid memo template ID %q", tokens[0])
+	}
+	return id, nil
+}
+
+// ExtractRecurringMemoIDFromName returns the recurring memo ID from a recurring memo name.
+func ExtractRecurringMemoIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, RecurringMemoNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid recurring memo ID %q", tokens[0])
+	}
+	return id, nil
+}
+
+// ExtractCollectionIDFromName returns the collection ID from a collection name.
+func ExtractCollectionIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, CollectionNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid collection ID %q", tokens[0])
+	}
+	return id, nil
+}
@@ -83,19 +83,48 @@ func (s *APIV1Service) CreateResource(ctx context.Context, request *v1pb.CreateR
 	return s.convertResourceFromStore(ctx, resource), nil
 }
 
-func (s *APIV1Service) ListResources(ctx context.Context, _ *v1pb.ListResourcesRequest) (*v1pb.ListResourcesResponse, error) {
+func (s *APIV1Service) ListResources(ctx context.Context, request *v1pb.ListResourcesRequest) (*v1pb.ListResourcesResponse, error) {
 	user, err := s.GetCurrentUser(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	resources, err := s.Store.ListResources(ctx, &store.FindResource{
+	resourceFind := &store.FindResource{
 		CreatorID: &user.ID,
-	})
+	}
+	limit, offset, cursor, err := parsePageToken(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	}
+	// List all resources unless a page is requested.
+	limitPlusOne := limit + 1
+	if limit > 0 {
+		resourceFind.Limit = &limitPlusOne
+		resourceFind.Offset = &offset
+	}
+	if cursor != nil {
+		resourceFind.Cursor = &store.ResourceCursor{
+			UpdatedTs: cursor.DisplayTs,
+			ID:        cursor.Id,
+		}
+	}
+	resources, err := s.Store.ListResources(ctx, resourceFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list resources: %v", err)
 	}
 
 	response := &v1pb.ListResourcesResponse{}
+	if limit > 0 && len(resources) == limitPlusOne {
+		resources = resources[:limit]
+		lastResource := resources[len(resources)-1]
+		nextPageToken, err := getCursorPageToken(limit, &v1pb.PageCursor{
+			DisplayTs: lastResource.UpdatedTs,
+			Id:        lastResource.ID,
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+		response.NextPageToken = nextPageToken
+	}
 	for _, resource := range resources {
 		response.Resources = append(response.Resources, s.convertResourceFromStore(ctx, resource))
 	}
@@ -175,7 +204,11 @@ func (s *APIV1Service) GetResourceBinary(ctx context.Context, request *v1pb.GetR
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to find memo by ID: %v", resource.MemoID)
 		}
-		if memo != nil && memo.Visibility != store.Public {
+		// The resources of the memos in the trash bin are hidden along with them.
+		if memo == nil {
+			return nil, status.Errorf(codes.NotFound, "resource not found")
+		}
+		if memo.Visibility != store.Public && !isMemoSharedInContext(ctx, memo.ID) {
 			user, err := s.GetCurrentUser(ctx)
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
@@ -184,7 +217,13 @@ func (s *APIV1Service) GetResourceBinary(ctx context.Context, request *v1pb.GetR
 				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
 			}
 			if memo.Visibility == store.Private && user.ID != resource.CreatorID {
-				return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
+				shared, err := s.hasMemoSharePermission(ctx, memo, user, store.MemoSharePermissionRead)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get memo share: %v", err)
+				}
+				if !shared {
+					return nil, status.Errorf(codes.Unauthenticated, "unauthorized access")
+				}
 			}
 		}
 	}
@@ -280,6 +319,22 @@ func (s *APIV1Service) DeleteResource(ctx context.Context, request *v1pb.DeleteR
 }
 
 func (s *APIV1Service) convertResourceFromStore(ctx context.Context, resource *store.Resource) *v1pb.Resource {
+	resourceMessage := convertResourceMessageFromStore(resource)
+	if resource.MemoID != nil {
+		memo, _ := s.Store.GetMemo(ctx, &store.FindMemo{
+			ID: resource.MemoID,
+		})
+		if memo != nil {
+			memoName := fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)
+			resourceMessage.Memo = &memoName
+		}
+	}
+
+	return resourceMessage
+}
+
+// convertResourceMessageFromStore converts the resource without looking up its memo.
+func convertResourceMessageFromStore(resource *store.Resource) *v1pb.Resource {
 	resourceMessage := &v1pb.Resource{
 		Name:       fmt.Sprintf("%s%d", ResourceNamePrefix, resource.ID),
 		Uid:        resource.UID,
@@ -291,16 +346,6 @@ func (s *APIV1Service) convertResourceFromStore(ctx context.Context, resource *s
 	if resource.StorageType == storepb.ResourceStorageType_EXTERNAL || resource.StorageType == storepb.ResourceStorageType_S3 {
 		resourceMessage.ExternalLink = resource.Reference
 	}
-	if resource.MemoID != nil {
-		memo, _ := s.Store.GetMemo(ctx, &store.FindMemo{
-			ID: resource.MemoID,
-		})
-		if memo != nil {
-			memoName := fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)
-			resourceMessage.Memo = &memoName
-		}
-	}
-
 	return resourceMessage
 }
 
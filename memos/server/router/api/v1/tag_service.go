@@ -0,0 +1,328 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+var tagColorRegex = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+func (s *APIV1Service) CreateTag(ctx context.Context, request *v1pb.CreateTagRequest) (*v1pb.Tag, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	if request.Tag == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag is required")
+	}
+
+	name := strings.TrimPrefix(request.Tag.Tag, "#")
+	if err := validateTagName(name); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %v", err)
+	}
+	if err := validateTagColor(request.Tag.Color); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid color: %v", err)
+	}
+	existing, err := s.Store.GetTag(ctx, &store.FindTag{
+		CreatorID: &user.ID,
+		Name:      &name,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag: %v", err)
+	}
+	if existing != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "tag %q already exists", name)
+	}
+
+	tag, err := s.Store.CreateTag(ctx, &store.Tag{
+		CreatorID:   user.ID,
+		Name:        name,
+		Color:       request.Tag.Color,
+		Emoji:       request.Tag.Emoji,
+		Description: request.Tag.Description,
+		Pinned:      request.Tag.Pinned,
+		SortOrder:   request.Tag.SortOrder,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create tag: %v", err)
+	}
+	return convertTagFromStore(tag), nil
+}
+
+func (s *APIV1Service) ListTags(ctx context.Context, _ *v1pb.ListTagsRequest) (*v1pb.ListTagsResponse, error) {
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	tags, err := s.Store.ListTags(ctx, &store.FindTag{
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tags: %v", err)
+	}
+	response := &v1pb.ListTagsResponse{
+		Tags: []*v1pb.Tag{},
+	}
+	for _, tag := range tags {
+		response.Tags = append(response.Tags, convertTagFromStore(tag))
+	}
+	return response, nil
+}
+
+func (s *APIV1Service) GetTag(ctx context.Context, request *v1pb.GetTagRequest) (*v1pb.Tag, error) {
+	tag, err := s.getCurrentUserTag(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return convertTagFromStore(tag), nil
+}
+
+func (s *APIV1Service) UpdateTag(ctx context.Context, request *v1pb.UpdateTagRequest) (*v1pb.Tag, error) {
+	if request.Tag == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag is required")
+	}
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	tag, err := s.getCurrentUserTag(ctx, request.Tag.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	currentTs := time.Now().Unix()
+	update := &store.UpdateTag{
+		ID:        tag.ID,
+		UpdatedTs: &currentTs,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		switch path {
+		case "tag":
+			name := strings.TrimPrefix(request.Tag.Tag, "#")
+			if err := validateTagName(name); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid tag: %v", err)
+			}
+			if name != tag.Name {
+				existing, err := s.Store.GetTag(ctx, &store.FindTag{
+					CreatorID: &tag.CreatorID,
+					Name:      &name,
+				})
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get tag: %v", err)
+				}
+				if existing != nil {
+					return nil, status.Errorf(codes.AlreadyExists, "tag %q already exists", name)
+				}
+			}
+			update.Name = &name
+		case "color":
+			if err := validateTagColor(request.Tag.Color); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid color: %v", err)
+			}
+			update.Color = &request.Tag.Color
+		case "emoji":
+			update.Emoji = &request.Tag.Emoji
+		case "description":
+			update.Description = &request.Tag.Description
+		case "pinned":
+			update.Pinned = &request.Tag.Pinned
+		case "sort_order":
+			update.SortOrder = &request.Tag.SortOrder
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+
+	tag, err = s.Store.UpdateTag(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update tag: %v", err)
+	}
+	return convertTagFromStore(tag), nil
+}
+
+func (s *APIV1Service) DeleteTag(ctx context.Context, request *v1pb.DeleteTagRequest) (*emptypb.Empty, error) {
+	tag, err := s.getCurrentUserTag(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteTag(ctx, &store.DeleteTag{
+		ID: tag.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete tag: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// getCurrentUserTag returns the tag with the given name, which must be owned by the current user.
+func (s *APIV1Service) getCurrentUserTag(ctx context.Context, name string) (*store.Tag, error) {
+	tagID, err := ExtractTagIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag name: %v", err)
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	tag, err := s.Store.GetTag(ctx, &store.FindTag{
+		ID:        &tagID,
+		CreatorID: &user.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag: %v", err)
+	}
+	if tag == nil {
+		return nil, status.Errorf(codes.NotFound, "tag not found")
+	}
+	return tag, nil
+}
+
+// renameTags renames the tags of the user along with the memo tags, so the metadata follows the memos.
+// If a tag with the new name already exists, it is kept and the renamed one is dropped.
+func renameTags(ctx context.Context, s *store.Store, userID int32, oldTag, newTag string, includeDescendants bool) error {
+	tags, err := s.ListTags(ctx, &store.FindTag{
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list tags")
+	}
+	tagByName := map[string]*store.Tag{}
+	for _, tag := range tags {
+		tagByName[tag.Name] = tag
+	}
+	for _, tag := range tags {
+		var name string
+		if tag.Name == oldTag {
+			name = newTag
+		} else if includeDescendants && isTagInSubtree(tag.Name, oldTag) {
+			name = newTag + strings.TrimPrefix(tag.Name, oldTag)
+		} else {
+			continue
+		}
+		if name == tag.Name {
+			continue
+		}
+		if _, ok := tagByName[name]; ok {
+			if err := s.DeleteTag(ctx, &store.DeleteTag{ID: tag.ID}); err != nil {
+				return errors.Wrap(err, "failed to delete tag")
+			}
+			continue
+		}
+		currentTs := time.Now().Unix()
+		if _, err := s.UpdateTag(ctx, &store.UpdateTag{
+			ID:        tag.ID,
+			UpdatedTs: &currentTs,
+			Name:      &name,
+		}); err != nil {
+			return errors.Wrap(err, "failed to update tag")
+		}
+		tagByName[name] = tag
+	}
+	return nil
+}
+
+// deleteTags deletes the tag of the user, and its descendants if includeDescendants is set.
+func deleteTags(ctx context.Context, s *store.Store, userID int32, name string, includeDescendants bool) error {
+	tags, err := s.ListTags(ctx, &store.FindTag{
+		CreatorID: &userID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list tags")
+	}
+	for _, tag := range tags {
+		if tag.Name != name && !(includeDescendants && isTagInSubtree(tag.Name, name)) {
+			continue
+		}
+		if err := s.DeleteTag(ctx, &store.DeleteTag{ID: tag.ID}); err != nil {
+			return errors.Wrap(err, "failed to delete tag")
+		}
+	}
+	return nil
+}
+
+// listTagMetadata returns the metadata of the tags keyed by name. As the tags may be used by memos of
+// different users, the metadata of the current user is preferred over the one of the memo creators.
+func (s *APIV1Service) listTagMetadata(ctx context.Context, memos []*store.Memo, tagNames []string) (map[string]*v1pb.Tag, error) {
+	tagMetadata := map[string]*v1pb.Tag{}
+	if len(tagNames) == 0 {
+		return tagMetadata, nil
+	}
+	user, err := s.GetCurrentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	creatorIDs := map[int32]bool{}
+	for _, memo := range memos {
+		creatorIDs[memo.CreatorID] = true
+	}
+
+	tags, err := s.Store.ListTags(ctx, &store.FindTag{
+		NameList: tagNames,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		if user != nil && tag.CreatorID == user.ID {
+			tagMetadata[tag.Name] = convertTagFromStore(tag)
+		} else if _, ok := tagMetadata[tag.Name]; !ok && creatorIDs[tag.CreatorID] {
+			tagMetadata[tag.Name] = convertTagFromStore(tag)
+		}
+	}
+	return tagMetadata, nil
+}
+
+func validateTagName(name string) error {
+	if name == "" {
+		return errors.New("tag is required")
+	}
+	if strings.ContainsAny(name, " \t\r\n#") {
+		return errors.Errorf("tag %q must not contain whitespace or \"#\"", name)
+	}
+	if strings.HasPrefix(name, MemoTagSeparator) || strings.HasSuffix(name, MemoTagSeparator) || strings.Contains(name, MemoTagSeparator+MemoTagSeparator) {
+		return errors.Errorf("tag %q has an empty segment", name)
+	}
+	return nil
+}
+
+func validateTagColor(color string) error {
+	if color != "" && !tagColorRegex.MatchString(color) {
+		return errors.Errorf("color %q must be a hex color like #ff0000", color)
+	}
+	return nil
+}
+
+func convertTagFromStore(tag *store.Tag) *v1pb.Tag {
+	return &v1pb.Tag{
+		Name:        fmt.Sprintf("%s%d", TagNamePrefix, tag.ID),
+		Creator:     fmt.Sprintf("%s%d", UserNamePrefix, tag.CreatorID),
+		CreateTime:  timestamppb.New(time.Unix(tag.CreatedTs, 0)),
+		UpdateTime:  timestamppb.New(time.Unix(tag.UpdatedTs, 0)),
+		Tag:         tag.Name,
+		Color:       tag.Color,
+		Emoji:       tag.Emoji,
+		Description: tag.Description,
+		Pinned:      tag.Pinned,
+		SortOrder:   tag.SortOrder,
+	}
+}
@@ -269,6 +269,7 @@ func getDefaultUserSetting() *v1pb.UserSetting {
 		Locale:         "en",
 		Appearance:     "system",
 		MemoVisibility: "PRIVATE",
+		Timezone:       "UTC",
 	}
 }
 
@@ -292,6 +293,10 @@ func (s *APIV1Service) GetUserSetting(ctx context.Context, _ *v1pb.GetUserSettin
 			userSettingMessage.Appearance = setting.GetAppearance()
 		} else if setting.Key == storepb.UserSettingKey_MEMO_VISIBILITY {
 			userSettingMessage.MemoVisibility = setting.GetMemoVisibility()
+		} else if setting.Key == storepb.UserSettingKey_DAILY_JOURNAL {
+			userSettingMessage.DailyJournal = convertDailyJournalSettingFromStore(setting.GetDailyJournal())
+		} else if setting.Key == storepb.UserSettingKey_TIMEZONE {
+			userSettingMessage.Timezone = setting.GetTimezone()
 		}
 	}
 	return userSettingMessage, nil
@@ -338,6 +343,40 @@ func (s *APIV1Service) UpdateUserSetting(ctx context.Context, request *v1pb.Upda
 			}); err != nil {
 				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
 			}
+		} else if field == "daily_journal" {
+			previous, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+				UserID: &user.ID,
+				Key:    storepb.UserSettingKey_DAILY_JOURNAL,
+			})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get user setting: %v", err)
+			}
+			dailyJournal, err := s.convertDailyJournalSettingToStore(ctx, request.Setting.DailyJournal, previous.GetDailyJournal())
+			if err != nil {
+				return nil, err
+			}
+			if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+				UserId: user.ID,
+				Key:    storepb.UserSettingKey_DAILY_JOURNAL,
+				Value: &storepb.UserSetting_DailyJournal{
+					DailyJournal: dailyJournal,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+			}
+		} else if field == "timezone" {
+			if _, err := time.LoadLocation(request.Setting.Timezone); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid timezone %q", request.Setting.Timezone)
+			}
+			if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
+				UserId: user.ID,
+				Key:    storepb.UserSettingKey_TIMEZONE,
+				Value: &storepb.UserSetting_Timezone{
+					Timezone: request.Setting.Timezone,
+				},
+			}); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+			}
 		} else {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", field)
 		}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	v1pb "github.com/usememos/memos/proto/gen/api/v1"
 	"github.com/usememos/memos/server/profile"
@@ -26,6 +27,11 @@ type APIV1Service struct {
 	v1pb.UnimplementedInboxServiceServer
 	v1pb.UnimplementedActivityServiceServer
 	v1pb.UnimplementedWebhookServiceServer
+	v1pb.UnimplementedTagServiceServer
+	v1pb.UnimplementedReminderServiceServer
+	v1pb.UnimplementedMemoTemplateServiceServer
+	v1pb.UnimplementedRecurringMemoServiceServer
+	v1pb.UnimplementedCollectionServiceServer
 	v1pb.UnimplementedMarkdownServiceServer
 	v1pb.UnimplementedIdentityProviderServiceServer
 
@@ -53,6 +59,11 @@ func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store
 	v1pb.RegisterInboxServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterActivityServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterWebhookServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterTagServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterReminderServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterMemoTemplateServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterRecurringMemoServiceServer(grpcServer, apiv1Service)
+	v1pb.RegisterCollectionServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterMarkdownServiceServer(grpcServer, apiv1Service)
 	v1pb.RegisterIdentityProviderServiceServer(grpcServer, apiv1Service)
 	reflection.Register(grpcServer)
@@ -70,7 +81,20 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 		return err
 	}
 
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &httpBodyStreamMarshaler{
+			HTTPBodyMarshaler: runtime.HTTPBodyMarshaler{
+				Marshaler: &runtime.JSONPb{
+					MarshalOptions: protojson.MarshalOptions{
+						EmitUnpopulated: true,
+					},
+					UnmarshalOptions: protojson.UnmarshalOptions{
+						DiscardUnknown: true,
+					},
+				},
+			},
+		}),
+	)
 	if err := v1pb.RegisterWorkspaceServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
@@ -98,6 +122,21 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 	if err := v1pb.RegisterWebhookServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
+	if err := v1pb.RegisterTagServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterReminderServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterMemoTemplateServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterRecurringMemoServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
+	if err := v1pb.RegisterCollectionServiceHandler(ctx, gwMux, conn); err != nil {
+		return err
+	}
 	if err := v1pb.RegisterMarkdownServiceHandler(ctx, gwMux, conn); err != nil {
 		return err
 	}
@@ -119,3 +158,13 @@ func (s *APIV1Service) RegisterGateway(ctx context.Context, echoServer *echo.Ech
 
 	return nil
 }
+
+// httpBodyStreamMarshaler is the default marshaler of the gateway, except that the chunks of
+// streamed responses are not delimited, so that binary streams like archives are written as is.
+type httpBodyStreamMarshaler struct {
+	runtime.HTTPBodyMarshaler
+}
+
+func (*httpBodyStreamMarshaler) Delimiter() []byte {
+	return nil
+}
@@ -223,6 +223,8 @@ func convertWorkspaceMemoRelatedSettingFromStore(setting *storepb.WorkspaceMemoR
 		ContentLengthLimit:    setting.ContentLengthLimit,
 		EnableAutoCompact:     setting.EnableAutoCompact,
 		EnableDoubleClickEdit: setting.EnableDoubleClickEdit,
+		MemoRevisionLimit:     setting.MemoRevisionLimit,
+		TrashRetentionDays:    setting.TrashRetentionDays,
 	}
 }
 
@@ -236,5 +238,7 @@ func convertWorkspaceMemoRelatedSettingToStore(setting *v1pb.WorkspaceMemoRelate
 		ContentLengthLimit:    setting.ContentLengthLimit,
 		EnableAutoCompact:     setting.EnableAutoCompact,
 		EnableDoubleClickEdit: setting.EnableDoubleClickEdit,
+		MemoRevisionLimit:     setting.MemoRevisionLimit,
+		TrashRetentionDays:    setting.TrashRetentionDays,
 	}
 }
@@ -16,6 +16,7 @@ import (
 
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/profile"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/store"
 )
 
@@ -47,6 +48,7 @@ func (s *RSSService) GetExploreRSS(c echo.Context) error {
 	memoFind := store.FindMemo{
 		RowStatus:      &normalStatus,
 		VisibilityList: []store.Visibility{store.Public},
+		Filter:         apiv1.GetPublishedMemoFilter(time.Now()),
 	}
 	memoList, err := s.Store.ListMemos(ctx, &memoFind)
 	if err != nil {
@@ -80,6 +82,7 @@ func (s *RSSService) GetUserRSS(c echo.Context) error {
 		CreatorID:      &user.ID,
 		RowStatus:      &normalStatus,
 		VisibilityList: []store.Visibility{store.Public},
+		Filter:         apiv1.GetPublishedMemoFilter(time.Now()),
 	}
 	memoList, err := s.Store.ListMemos(ctx, &memoFind)
 	if err != nil {
@@ -21,7 +21,10 @@ import (
 	apiv1 "github.com/usememos/memos/server/router/api/v1"
 	"github.com/usememos/memos/server/router/frontend"
 	"github.com/usememos/memos/server/router/rss"
+	reminderscheduler "github.com/usememos/memos/server/service/reminder_scheduler"
+	"github.com/usememos/memos/server/service/runner"
 	s3objectpresigner "github.com/usememos/memos/server/service/s3_object_presigner"
+	trashpurger "github.com/usememos/memos/server/service/trash_purger"
 	versionchecker "github.com/usememos/memos/server/service/version_checker"
 	"github.com/usememos/memos/store"
 )
@@ -31,8 +34,9 @@ type Server struct {
 	Profile *profile.Profile
 	Store   *store.Store
 
-	echoServer *echo.Echo
-	grpcServer *grpc.Server
+	echoServer   *echo.Echo
+	grpcServer   *grpc.Server
+	apiV1Service *apiv1.APIV1Service
 }
 
 func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store) (*Server, error) {
@@ -72,17 +76,23 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 	// Create and register RSS routes.
 	rss.NewRSSService(s.Profile, s.Store).RegisterRoutes(rootGroup)
 
+	authInterceptor := apiv1.NewGRPCAuthInterceptor(store, secret)
 	grpcServer := grpc.NewServer(
 		// Override the maximum receiving message size to 100M for uploading large resources.
 		grpc.MaxRecvMsgSize(100*1024*1024),
 		grpc.ChainUnaryInterceptor(
 			apiv1.NewLoggerInterceptor().LoggerInterceptor,
 			grpc_recovery.UnaryServerInterceptor(),
-			apiv1.NewGRPCAuthInterceptor(store, secret).AuthenticationInterceptor,
+			authInterceptor.AuthenticationInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			grpc_recovery.StreamServerInterceptor(),
+			authInterceptor.StreamAuthenticationInterceptor,
 		))
 	s.grpcServer = grpcServer
 
 	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store, grpcServer)
+	s.apiV1Service = apiV1Service
 	// Register gRPC gateway as api v1.
 	if err := apiV1Service.RegisterGateway(ctx, echoServer); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
@@ -142,6 +152,12 @@ func (s *Server) Shutdown(ctx context.Context) {
 func (s *Server) StartBackgroundRunners(ctx context.Context) {
 	go versionchecker.NewVersionChecker(s.Store, s.Profile).Start(ctx)
 	go s3objectpresigner.NewS3ObjectPresigner(s.Store).Start(ctx)
+	go trashpurger.NewTrashPurger(s.Store).Start(ctx)
+	go reminderscheduler.NewReminderScheduler(s.Store, s.apiV1Service).Start(ctx)
+	go runner.NewRunner("publishScheduledMemos", "* * * * *", s.apiV1Service.PublishScheduledMemos).Start(ctx)
+	go runner.NewRunner("expireMemos", "* * * * *", s.apiV1Service.ExpireMemos).Start(ctx)
+	go runner.NewRunner("createDailyJournals", "* * * * *", s.apiV1Service.CreateDueDailyJournals).Start(ctx)
+	go runner.NewRunner("runRecurringMemos", "* * * * *", s.apiV1Service.RunDueRecurringMemos).Start(ctx)
 }
 
 func (s *Server) getOrUpsertWorkspaceBasicSetting(ctx context.Context) (*storepb.WorkspaceBasicSetting, error) {
@@ -0,0 +1,133 @@
+package reminderscheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/service/runner"
+	"github.com/usememos/memos/store"
+)
+
+// WebhookDispatcher dispatches the webhooks of the memos whose reminders fire.
+type WebhookDispatcher interface {
+	DispatchMemoReminderWebhook(ctx context.Context, memo *store.Memo) error
+}
+
+// ReminderScheduler fires the due reminders, delivering them to the inbox of their creators.
+type ReminderScheduler struct {
+	Store             *store.Store
+	WebhookDispatcher WebhookDispatcher
+}
+
+func NewReminderScheduler(store *store.Store, webhookDispatcher WebhookDispatcher) *ReminderScheduler {
+	return &ReminderScheduler{
+		Store:             store,
+		WebhookDispatcher: webhookDispatcher,
+	}
+}
+
+// Fire fires the pending reminders that are due at the given time.
+func (s *ReminderScheduler) Fire(ctx context.Context, now time.Time) error {
+	pending, remindTsBefore := true, now.Unix()+1
+	reminders, err := s.Store.ListMemoReminders(ctx, &store.FindMemoReminder{
+		Pending:        &pending,
+		RemindTsBefore: &remindTsBefore,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo reminders")
+	}
+	for _, reminder := range reminders {
+		if err := s.fireReminder(ctx, reminder, now); err != nil {
+			slog.Warn("Failed to fire reminder", slog.Int("reminder", int(reminder.ID)), slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+func (s *ReminderScheduler) fireReminder(ctx context.Context, reminder *store.MemoReminder, now time.Time) error {
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		ID:             &reminder.MemoID,
+		IncludeTrashed: true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return s.Store.DeleteMemoReminder(ctx, &store.DeleteMemoReminder{ID: &reminder.ID})
+	}
+	// The reminders of archived and trashed memos fire once the memos are restored.
+	if memo.RowStatus != store.Normal || memo.DeletedTs != 0 {
+		return nil
+	}
+
+	notify := true
+	if reminder.TaskPosition != nil {
+		task, err := s.Store.GetMemoTask(ctx, &store.FindMemoTask{
+			MemoID:   &memo.ID,
+			Position: reminder.TaskPosition,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to get memo task")
+		}
+		// There is nothing to remind of if the task is done or gone.
+		notify = task != nil && !task.Completed
+	}
+
+	firedTs := now.Unix()
+	if err := s.Store.WithTx(ctx, func(txStore *store.Store) error {
+		if _, err := txStore.UpdateMemoReminder(ctx, &store.UpdateMemoReminder{
+			ID:      reminder.ID,
+			FiredTs: &firedTs,
+		}); err != nil {
+			return errors.Wrap(err, "failed to update memo reminder")
+		}
+		if !notify {
+			return nil
+		}
+		activity, err := txStore.CreateActivity(ctx, &store.Activity{
+			CreatorID: store.SystemBotID,
+			Type:      store.ActivityTypeReminder,
+			Level:     store.ActivityLevelInfo,
+			Payload: &storepb.ActivityPayload{
+				Reminder: &storepb.ActivityReminderPayload{
+					ReminderId:   reminder.ID,
+					MemoId:       memo.ID,
+					TaskPosition: reminder.TaskPosition,
+				},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create activity")
+		}
+		if _, err := txStore.CreateInbox(ctx, &store.Inbox{
+			SenderID:   store.SystemBotID,
+			ReceiverID: reminder.CreatorID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type:       storepb.InboxMessage_REMINDER,
+				ActivityId: &activity.ID,
+			},
+		}); err != nil {
+			return errors.Wrap(err, "failed to create inbox")
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if notify && s.WebhookDispatcher != nil {
+		if err := s.WebhookDispatcher.DispatchMemoReminderWebhook(ctx, memo); err != nil {
+			slog.Warn("Failed to dispatch memo reminder webhook", slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+func (s *ReminderScheduler) Start(ctx context.Context) {
+	// Schedule runner every minute.
+	runner.NewRunner("fireReminders", "* * * * *", s.Fire).Start(ctx)
+}
@@ -0,0 +1,84 @@
+package reminderscheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/test/store"
+)
+
+type recordingDispatcher struct {
+	memoIDs []int32
+}
+
+func (d *recordingDispatcher) DispatchMemoReminderWebhook(_ context.Context, memo *store.Memo) error {
+	d.memoIDs = append(d.memoIDs, memo.ID)
+	return nil
+}
+
+func TestFire(t *testing.T) {
+	ctx := context.Background()
+	ts := teststore.NewTestingStore(ctx, t)
+	defer ts.Close()
+	user, err := ts.CreateUser(ctx, &store.User{
+		Username: "test",
+		Role:     store.RoleHost,
+		Email:    "test@test.com",
+	})
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-reminder",
+		CreatorID:  user.ID,
+		Content:    "- [x] done",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemoTask(ctx, &store.MemoTask{
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+		Content:   "done",
+		Completed: true,
+	})
+	require.NoError(t, err)
+
+	now := time.Unix(1000, 0)
+	position := int32(0)
+	dueReminder, err := ts.CreateMemoReminder(ctx, &store.MemoReminder{MemoID: memo.ID, CreatorID: user.ID, RemindTs: now.Unix()})
+	require.NoError(t, err)
+	taskReminder, err := ts.CreateMemoReminder(ctx, &store.MemoReminder{MemoID: memo.ID, CreatorID: user.ID, TaskPosition: &position, RemindTs: now.Unix() - 60})
+	require.NoError(t, err)
+	laterReminder, err := ts.CreateMemoReminder(ctx, &store.MemoReminder{MemoID: memo.ID, CreatorID: user.ID, RemindTs: now.Unix() + 60})
+	require.NoError(t, err)
+
+	dispatcher := &recordingDispatcher{}
+	scheduler := NewReminderScheduler(ts, dispatcher)
+	require.NoError(t, scheduler.Fire(ctx, now))
+	// Firing again does not notify twice.
+	require.NoError(t, scheduler.Fire(ctx, now))
+
+	getReminder := func(id int32) *store.MemoReminder {
+		reminder, err := ts.GetMemoReminder(ctx, &store.FindMemoReminder{ID: &id})
+		require.NoError(t, err)
+		return reminder
+	}
+	require.Equal(t, now.Unix(), getReminder(dueReminder.ID).FiredTs)
+	require.Equal(t, now.Unix(), getReminder(taskReminder.ID).FiredTs)
+	require.Equal(t, int64(0), getReminder(laterReminder.ID).FiredTs)
+
+	// Only the memo reminder is notified, as the task is already completed.
+	require.Equal(t, []int32{memo.ID}, dispatcher.memoIDs)
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{ReceiverID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(inboxes))
+	require.Equal(t, storepb.InboxMessage_REMINDER, inboxes[0].Message.Type)
+	activity, err := ts.GetActivity(ctx, &store.FindActivity{ID: inboxes[0].Message.ActivityId})
+	require.NoError(t, err)
+	require.Equal(t, store.ActivityTypeReminder, activity.Type)
+	require.Equal(t, dueReminder.ID, activity.Payload.Reminder.ReminderId)
+	require.Equal(t, memo.ID, activity.Payload.Reminder.MemoId)
+}
@@ -0,0 +1,48 @@
+package runner
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/usememos/memos/internal/cron"
+)
+
+// Job does the work of a runner as of the given time.
+type Job func(ctx context.Context, now time.Time) error
+
+// Runner runs a job on a cron schedule until its context is done.
+type Runner struct {
+	Name     string
+	CronExpr string
+	Job      Job
+}
+
+func NewRunner(name string, cronExpr string, job Job) *Runner {
+	return &Runner{
+		Name:     name,
+		CronExpr: cronExpr,
+		Job:      job,
+	}
+}
+
+// Run runs the job once, logging its failure.
+func (r *Runner) Run(ctx context.Context, now time.Time) {
+	if err := r.Job(ctx, now); err != nil {
+		slog.Error("Failed to run job", slog.String("job", r.Name), slog.Any("err", err))
+	}
+}
+
+// Start runs the job right away, and then on the schedule.
+func (r *Runner) Start(ctx context.Context) {
+	r.Run(ctx, time.Now())
+
+	c := cron.New()
+	c.MustAdd(r.Name, r.CronExpr, func() {
+		r.Run(ctx, time.Now())
+	})
+	c.Start()
+	defer c.Stop()
+
+	<-ctx.Done()
+}
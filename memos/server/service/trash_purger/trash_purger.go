@@ -0,0 +1,29 @@
+package trashpurger
+
+import (
+	"context"
+	"time"
+
+	"github.com/usememos/memos/server/service/runner"
+	"github.com/usememos/memos/store"
+)
+
+// TrashPurger permanently deletes memos that have been in the trash bin longer than the retention period.
+type TrashPurger struct {
+	Store *store.Store
+}
+
+func NewTrashPurger(store *store.Store) *TrashPurger {
+	return &TrashPurger{
+		Store: store,
+	}
+}
+
+func (p *TrashPurger) Purge(ctx context.Context, _ time.Time) error {
+	return p.Store.PurgeTrashedMemos(ctx)
+}
+
+func (p *TrashPurger) Start(ctx context.Context) {
+	// Schedule runner at minute 0 past every hour.
+	runner.NewRunner("purgeTrashedMemos", "0 * * * *", p.Purge).Start(ctx)
+}
@@ -11,6 +11,8 @@ type ActivityType string
 const (
 	ActivityTypeMemoComment   ActivityType = "MEMO_COMMENT"
 	ActivityTypeVersionUpdate ActivityType = "VERSION_UPDATE"
+	ActivityTypeReminder      ActivityType = "REMINDER"
+	ActivityTypeMemoShare     ActivityType = "MEMO_SHARE"
 )
 
 func (t ActivityType) String() string {
@@ -2,8 +2,18 @@ package store
 
 import (
 	"fmt"
+	"sync"
 )
 
 func getUserSettingCacheKey(userID int32, key string) string {
 	return fmt.Sprintf("%d-%s", userID, key)
 }
+
+func (s *Store) resetCaches() {
+	for _, cache := range []*sync.Map{&s.workspaceSettingCache, &s.userCache, &s.userSettingCache, &s.idpCache} {
+		cache.Range(func(key, _ any) bool {
+			cache.Delete(key)
+			return true
+		})
+	}
+}
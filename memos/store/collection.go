@@ -0,0 +1,92 @@
+package store
+
+import (
+	"context"
+)
+
+// Collection is a named and ordered set of memos of its creator.
+type Collection struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+	UpdatedTs int64
+
+	Title       string
+	Description string
+	Visibility  Visibility
+}
+
+type FindCollection struct {
+	ID             *int32
+	CreatorID      *int32
+	VisibilityList []Visibility
+}
+
+type UpdateCollection struct {
+	ID          int32
+	UpdatedTs   *int64
+	Title       *string
+	Description *string
+	Visibility  *Visibility
+}
+
+type DeleteCollection struct {
+	ID int32
+}
+
+// CollectionMemo is a memo in a collection, ordered by its position starting from 0.
+type CollectionMemo struct {
+	CollectionID int32
+	MemoID       int32
+	Position     int32
+}
+
+type FindCollectionMemo struct {
+	CollectionID *int32
+	MemoID       *int32
+}
+
+type DeleteCollectionMemo struct {
+	CollectionID *int32
+	MemoID       *int32
+}
+
+func (s *Store) CreateCollection(ctx context.Context, create *Collection) (*Collection, error) {
+	return s.driver.CreateCollection(ctx, create)
+}
+
+func (s *Store) ListCollections(ctx context.Context, find *FindCollection) ([]*Collection, error) {
+	return s.driver.ListCollections(ctx, find)
+}
+
+func (s *Store) GetCollection(ctx context.Context, find *FindCollection) (*Collection, error) {
+	list, err := s.ListCollections(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateCollection(ctx context.Context, update *UpdateCollection) (*Collection, error) {
+	return s.driver.UpdateCollection(ctx, update)
+}
+
+func (s *Store) DeleteCollection(ctx context.Context, delete *DeleteCollection) error {
+	return s.driver.DeleteCollection(ctx, delete)
+}
+
+func (s *Store) UpsertCollectionMemo(ctx context.Context, upsert *CollectionMemo) (*CollectionMemo, error) {
+	return s.driver.UpsertCollectionMemo(ctx, upsert)
+}
+
+// ListCollectionMemos lists the memos of collections, ordered by their positions.
+func (s *Store) ListCollectionMemos(ctx context.Context, find *FindCollectionMemo) ([]*CollectionMemo, error) {
+	return s.driver.ListCollectionMemos(ctx, find)
+}
+
+func (s *Store) DeleteCollectionMemo(ctx context.Context, delete *DeleteCollectionMemo) error {
+	return s.driver.DeleteCollectionMemo(ctx, delete)
+}
@@ -52,9 +52,25 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.ID; v != nil {
 		where, args = append(where, "`memo`.`id` = ?"), append(args, *v)
 	}
+	if v := find.IDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, "`memo`.`id` IN ("+strings.Join(holders, ", ")+")")
+	}
 	if v := find.UID; v != nil {
 		where, args = append(where, "`memo`.`uid` = ?"), append(args, *v)
 	}
+	if v := find.UIDList; len(v) != 0 {
+		holders := []string{}
+		for _, uid := range v {
+			holders = append(holders, "?")
+			args = append(args, uid)
+		}
+		where = append(where, "`memo`.`uid` IN ("+strings.Join(holders, ", ")+")")
+	}
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
 	}
@@ -73,11 +89,29 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.UpdatedTsAfter; v != nil {
 		where, args = append(where, "UNIX_TIMESTAMP(`memo`.`updated_ts`) > ?"), append(args, *v)
 	}
+	if find.Trashed {
+		where = append(where, "`memo`.`deleted_ts` > 0")
+	} else if !find.IncludeTrashed {
+		where = append(where, "`memo`.`deleted_ts` = 0")
+	}
+	if v := find.DeletedTsBefore; v != nil {
+		where, args = append(where, "`memo`.`deleted_ts` < ?"), append(args, *v)
+	}
 	if v := find.ContentSearch; len(v) != 0 {
 		for _, s := range v {
 			where, args = append(where, "`memo`.`content` LIKE ?"), append(args, "%"+s+"%")
 		}
 	}
+	fullTextQuery := ""
+	if v := find.ContentQuery; v != nil {
+		terms := []string{}
+		for _, term := range strings.Fields(*v) {
+			// Every term is required and matched as a phrase of ngrams.
+			terms = append(terms, `+"`+strings.ReplaceAll(term, `"`, "")+`"`)
+		}
+		fullTextQuery = strings.Join(terms, " ")
+		where, args = append(where, "MATCH(`memo`.`content`) AGAINST (? IN BOOLEAN MODE)"), append(args, fullTextQuery)
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		placeholder := []string{}
 		for _, visibility := range v {
@@ -106,11 +140,41 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			where = append(where, "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') IS TRUE")
 		}
 	}
+	if v := find.Filter; v != nil {
+		condition, newArgs, err := renderMemoFilter(v, args)
+		if err != nil {
+			return nil, err
+		}
+		where, args = append(where, condition), newArgs
+	}
+	if v := find.ParentID; v != nil {
+		where, args = append(where, "`memo_relation`.`related_memo_id` = ?"), append(args, *v)
+	}
 	if find.ExcludeComments {
 		having = append(having, "`parent_id` IS NULL")
 	}
+	if v := find.Cursor; v != nil {
+		displayTsColumn := "UNIX_TIMESTAMP(`memo`.`created_ts`)"
+		if find.OrderByUpdatedTs {
+			displayTsColumn = "UNIX_TIMESTAMP(`memo`.`updated_ts`)"
+		}
+		condition := fmt.Sprintf("(%s < ? OR (%s = ? AND `memo`.`id` < ?))", displayTsColumn, displayTsColumn)
+		cursorArgs := []any{v.DisplayTs, v.DisplayTs, v.ID}
+		if find.OrderByPinned {
+			pinned := 0
+			if v.Pinned {
+				pinned = 1
+			}
+			condition = fmt.Sprintf("(IFNULL(`memo_organizer`.`pinned`, 0) < ? OR (IFNULL(`memo_organizer`.`pinned`, 0) = ? AND %s))", condition)
+			cursorArgs = append([]any{pinned, pinned}, cursorArgs...)
+		}
+		where, args = append(where, condition), append(args, cursorArgs...)
+	}
 
 	orders := []string{}
+	if find.OrderByRelevance && fullTextQuery != "" {
+		orders, args = append(orders, "MATCH(`memo`.`content`) AGAINST (? IN BOOLEAN MODE) DESC"), append(args, fullTextQuery)
+	}
 	if find.OrderByPinned {
 		orders = append(orders, "`pinned` DESC")
 	}
@@ -130,6 +194,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		"`memo`.`creator_id` AS `creator_id`",
 		"UNIX_TIMESTAMP(`memo`.`created_ts`) AS `created_ts`",
 		"UNIX_TIMESTAMP(`memo`.`updated_ts`) AS `updated_ts`",
+		"`memo`.`deleted_ts` AS `deleted_ts`",
 		"`memo`.`row_status` AS `row_status`",
 		"`memo`.`visibility` AS `visibility`",
 		"`memo`.`payload` AS `payload`",
@@ -164,6 +229,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.CreatorID,
 			&memo.CreatedTs,
 			&memo.UpdatedTs,
+			&memo.DeletedTs,
 			&memo.RowStatus,
 			&memo.Visibility,
 			&payloadBytes,
@@ -215,6 +281,9 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if v := update.UpdatedTs; v != nil {
 		set, args = append(set, "`updated_ts` = FROM_UNIXTIME(?)"), append(args, *v)
 	}
+	if v := update.DeletedTs; v != nil {
+		set, args = append(set, "`deleted_ts` = ?"), append(args, *v)
+	}
 	if v := update.RowStatus; v != nil {
 		set, args = append(set, "`row_status` = ?"), append(args, *v)
 	}
@@ -231,12 +300,26 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 		}
 		set, args = append(set, "`payload` = ?"), append(args, string(payloadBytes))
 	}
+	where := []string{"`id` = ?"}
 	args = append(args, update.ID)
+	if v := update.ExpectedUpdatedTs; v != nil {
+		where, args = append(where, "UNIX_TIMESTAMP(`updated_ts`) = ?"), append(args, *v)
+	}
 
-	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE " + strings.Join(where, " AND ")
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
 		return err
 	}
+	if update.ExpectedUpdatedTs != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrMemoChanged
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,154 @@
+package mysql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// likePatternEscaper escapes the wildcards of LIKE patterns, which are matched with `\` as the escape character.
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+var memoFilterColumns = map[store.MemoFilterField]string{
+	store.MemoFilterFieldContent:    "`memo`.`content`",
+	store.MemoFilterFieldVisibility: "`memo`.`visibility`",
+	store.MemoFilterFieldCreatorID:  "`memo`.`creator_id`",
+	store.MemoFilterFieldUID:        "`memo`.`uid`",
+	store.MemoFilterFieldRowStatus:  "`memo`.`row_status`",
+	store.MemoFilterFieldCreatedTs:  "UNIX_TIMESTAMP(`memo`.`created_ts`)",
+	store.MemoFilterFieldUpdatedTs:  "UNIX_TIMESTAMP(`memo`.`updated_ts`)",
+	store.MemoFilterFieldPublishTs:  "COALESCE(CAST(JSON_UNQUOTE(JSON_EXTRACT(`memo`.`payload`, '$.schedule.publishTs')) AS SIGNED), 0)",
+	store.MemoFilterFieldExpireTs:   "COALESCE(CAST(JSON_UNQUOTE(JSON_EXTRACT(`memo`.`payload`, '$.expiration.expireTs')) AS SIGNED), 0)",
+}
+
+var memoFilterPayloadProperties = map[store.MemoFilterField]string{
+	store.MemoFilterFieldHasLink:            "hasLink",
+	store.MemoFilterFieldHasTaskList:        "hasTaskList",
+	store.MemoFilterFieldHasCode:            "hasCode",
+	store.MemoFilterFieldHasIncompleteTasks: "hasIncompleteTasks",
+}
+
+// renderMemoFilter renders the memo filter to a SQL condition and appends its arguments to args.
+func renderMemoFilter(filter store.MemoFilter, args []any) (string, []any, error) {
+	switch f := filter.(type) {
+	case *store.MemoFilterAnd:
+		return renderMemoFilterList(f.Filters, " AND ", "1 = 1", args)
+	case *store.MemoFilterOr:
+		return renderMemoFilterList(f.Filters, " OR ", "1 = 0", args)
+	case *store.MemoFilterNot:
+		condition, args, err := renderMemoFilter(f.Filter, args)
+		if err != nil {
+			return "", nil, err
+		}
+		return "NOT (" + condition + ")", args, nil
+	case *store.MemoFilterCondition:
+		return renderMemoFilterCondition(f, args)
+	default:
+		return "", nil, errors.Errorf("unsupported memo filter %T", filter)
+	}
+}
+
+func renderMemoFilterList(filters []store.MemoFilter, separator, empty string, args []any) (string, []any, error) {
+	if len(filters) == 0 {
+		return empty, args, nil
+	}
+	conditions := []string{}
+	for _, filter := range filters {
+		condition, newArgs, err := renderMemoFilter(filter, args)
+		if err != nil {
+			return "", nil, err
+		}
+		conditions, args = append(conditions, condition), newArgs
+	}
+	return "(" + strings.Join(conditions, separator) + ")", args, nil
+}
+
+func renderMemoFilterCondition(condition *store.MemoFilterCondition, args []any) (string, []any, error) {
+	if column, ok := memoFilterColumns[condition.Field]; ok {
+		switch condition.Operator {
+		case store.MemoFilterOperatorEqual, store.MemoFilterOperatorNotEqual,
+			store.MemoFilterOperatorLess, store.MemoFilterOperatorLessOrEqual,
+			store.MemoFilterOperatorGreater, store.MemoFilterOperatorGreaterOrEqual:
+			return fmt.Sprintf("%s %s ?", column, condition.Operator), append(args, condition.Value), nil
+		case store.MemoFilterOperatorIn:
+			values, ok := condition.Value.([]any)
+			if !ok || len(values) == 0 {
+				return "", nil, errors.Errorf("invalid values for %s", condition.Field)
+			}
+			placeholder := []string{}
+			for _, value := range values {
+				placeholder, args = append(placeholder, "?"), append(args, value)
+			}
+			return fmt.Sprintf("%s IN (%s)", column, strings.Join(placeholder, ", ")), args, nil
+		case store.MemoFilterOperatorStartsWith:
+			return column + " LIKE ? ESCAPE '\\\\'", append(args, fmt.Sprintf("%s%%", likePatternEscaper.Replace(fmt.Sprint(condition.Value)))), nil
+		case store.MemoFilterOperatorContains:
+			return column + " LIKE ? ESCAPE '\\\\'", append(args, fmt.Sprintf("%%%s%%", likePatternEscaper.Replace(fmt.Sprint(condition.Value)))), nil
+		}
+	} else if property, ok := memoFilterPayloadProperties[condition.Field]; ok {
+		value, ok := condition.Value.(bool)
+		if ok && (condition.Operator == store.MemoFilterOperatorEqual || condition.Operator == store.MemoFilterOperatorNotEqual) {
+			if value == (condition.Operator == store.MemoFilterOperatorNotEqual) {
+				return fmt.Sprintf("JSON_EXTRACT(`memo`.`payload`, '$.property.%s') IS NOT TRUE", property), args, nil
+			}
+			return fmt.Sprintf("JSON_EXTRACT(`memo`.`payload`, '$.property.%s') IS TRUE", property), args, nil
+		}
+	} else if condition.Field == store.MemoFilterFieldTag {
+		switch condition.Operator {
+		case store.MemoFilterOperatorEqual:
+			tags, err := json.Marshal([]any{condition.Value})
+			if err != nil {
+				return "", nil, err
+			}
+			return "JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.property.tags'), ?)", append(args, string(tags)), nil
+		// JSON_SEARCH matches LIKE patterns with `\` as the default escape character.
+		case store.MemoFilterOperatorStartsWith:
+			return "JSON_SEARCH(JSON_EXTRACT(`memo`.`payload`, '$.property.tags'), 'one', ?) IS NOT NULL", append(args, fmt.Sprintf("%s%%", likePatternEscaper.Replace(fmt.Sprint(condition.Value)))), nil
+		case store.MemoFilterOperatorContains:
+			return "JSON_SEARCH(JSON_EXTRACT(`memo`.`payload`, '$.property.tags'), 'one', ?) IS NOT NULL", append(args, fmt.Sprintf("%%%s%%", likePatternEscaper.Replace(fmt.Sprint(condition.Value)))), nil
+		case store.MemoFilterOperatorSubtree:
+			tags, err := json.Marshal([]any{condition.Value})
+			if err != nil {
+				return "", nil, err
+			}
+			return "(JSON_CONTAINS(JSON_EXTRACT(`memo`.`payload`, '$.property.tags'), ?) OR JSON_SEARCH(JSON_EXTRACT(`memo`.`payload`, '$.property.tags'), 'one', ?) IS NOT NULL)", append(args, string(tags), fmt.Sprintf("%s/%%", likePatternEscaper.Replace(fmt.Sprint(condition.Value)))), nil
+		}
+	} else if condition.Field == store.MemoFilterFieldSharedWith && condition.Operator == store.MemoFilterOperatorEqual {
+		return "`memo`.`id` IN (SELECT `memo_id` FROM `memo_share` WHERE `user_id` = ?)", append(args, condition.Value), nil
+	} else if condition.Field == store.MemoFilterFieldCollectionID && condition.Operator == store.MemoFilterOperatorEqual {
+		return "`memo`.`id` IN (SELECT `memo_id` FROM `collection_memo` WHERE `collection_id` = ?)", append(args, condition.Value), nil
+	} else if condition.Field == store.MemoFilterFieldCustomProperty {
+		return renderMemoFilterCustomPropertyCondition(condition, args)
+	}
+	return "", nil, errors.Errorf("unsupported operator %s for %s", condition.Operator, condition.Field)
+}
+
+// renderMemoFilterCustomPropertyCondition compares the value of the custom property with the value of the same type.
+func renderMemoFilterCustomPropertyCondition(condition *store.MemoFilterCondition, args []any) (string, []any, error) {
+	switch condition.Operator {
+	case store.MemoFilterOperatorEqual, store.MemoFilterOperatorNotEqual,
+		store.MemoFilterOperatorLess, store.MemoFilterOperatorLessOrEqual,
+		store.MemoFilterOperatorGreater, store.MemoFilterOperatorGreaterOrEqual:
+	default:
+		return "", nil, errors.Errorf("unsupported operator %s for %s", condition.Operator, condition.Field)
+	}
+	switch value := condition.Value.(type) {
+	case string:
+		path := fmt.Sprintf("$.customProperties.%q.stringValue", condition.Key)
+		return fmt.Sprintf("JSON_UNQUOTE(JSON_EXTRACT(`memo`.`payload`, ?)) %s ?", condition.Operator), append(args, path, value), nil
+	case float64:
+		path := fmt.Sprintf("$.customProperties.%q.numberValue", condition.Key)
+		return fmt.Sprintf("CAST(JSON_EXTRACT(`memo`.`payload`, ?) AS DOUBLE) %s ?", condition.Operator), append(args, path, value), nil
+	case bool:
+		// JSON booleans are only equal to JSON values, not to integers.
+		path := fmt.Sprintf("$.customProperties.%q.boolValue", condition.Key)
+		return fmt.Sprintf("JSON_EXTRACT(`memo`.`payload`, ?) %s CAST(? AS JSON)", condition.Operator), append(args, path, strconv.FormatBool(value)), nil
+	default:
+		return "", nil, errors.Errorf("invalid value for %s", condition.Field)
+	}
+}
@@ -8,13 +8,14 @@ import (
 )
 
 func (d *DB) UpsertMemoRelation(ctx context.Context, create *store.MemoRelation) (*store.MemoRelation, error) {
-	stmt := "INSERT INTO `memo_relation` (`memo_id`, `related_memo_id`, `type`) VALUES (?, ?, ?)"
+	stmt := "INSERT INTO `memo_relation` (`memo_id`, `related_memo_id`, `type`) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE `type` = ?"
 	_, err := d.db.ExecContext(
 		ctx,
 		stmt,
 		create.MemoID,
 		create.RelatedMemoID,
 		create.Type,
+		create.Type,
 	)
 	if err != nil {
 		return nil, err
@@ -37,6 +38,22 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 	if find.RelatedMemoID != nil {
 		where, args = append(where, "`related_memo_id` = ?"), append(args, find.RelatedMemoID)
 	}
+	if v := find.MemoIDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, "`memo_id` IN ("+strings.Join(holders, ", ")+")")
+	}
+	if v := find.RelatedMemoIDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, "`related_memo_id` IN ("+strings.Join(holders, ", ")+")")
+	}
 	if find.Type != nil {
 		where, args = append(where, "`type` = ?"), append(args, find.Type)
 	}
@@ -88,3 +105,80 @@ func (d *DB) DeleteMemoRelation(ctx context.Context, delete *store.DeleteMemoRel
 	}
 	return nil
 }
+
+func (d *DB) ListMemoGraphNodes(ctx context.Context, find *store.FindMemoGraph) ([]*store.MemoGraphNode, error) {
+	if len(find.RootMemoIDList) == 0 {
+		return []*store.MemoGraphNode{}, nil
+	}
+
+	args := []any{}
+	// memoCondition limits the memos to traverse to the normal memos matching the filter.
+	memoCondition := func() (string, error) {
+		conditions := []string{"`memo`.`row_status` = ?", "`memo`.`deleted_ts` = 0"}
+		args = append(args, store.Normal)
+		if v := find.Filter; v != nil {
+			condition, newArgs, err := renderMemoFilter(v, args)
+			if err != nil {
+				return "", err
+			}
+			conditions, args = append(conditions, condition), newArgs
+		}
+		return strings.Join(conditions, " AND "), nil
+	}
+
+	holders := []string{}
+	for _, id := range find.RootMemoIDList {
+		holders = append(holders, "?")
+		args = append(args, id)
+	}
+	rootCondition, err := memoCondition()
+	if err != nil {
+		return nil, err
+	}
+	rootWhere := []string{"`memo`.`id` IN (" + strings.Join(holders, ", ") + ")", rootCondition}
+	where := []string{"`memo_graph`.`depth` < ?"}
+	args = append(args, find.MaxDepth)
+	if v := find.TypeList; len(v) != 0 {
+		holders := []string{}
+		for _, relationType := range v {
+			holders = append(holders, "?")
+			args = append(args, relationType)
+		}
+		where = append(where, "`memo_relation`.`type` IN ("+strings.Join(holders, ", ")+")")
+	}
+	condition, err := memoCondition()
+	if err != nil {
+		return nil, err
+	}
+	where = append(where, condition)
+
+	// The depth is bounded in the recursive query, and each memo is kept at the least depth it is reached at.
+	stmt := "WITH RECURSIVE `memo_graph` (`memo_id`, `depth`) AS (" +
+		"SELECT `memo`.`id`, 0 FROM `memo` WHERE " + strings.Join(rootWhere, " AND ") +
+		" UNION " +
+		"SELECT `memo`.`id`, `memo_graph`.`depth` + 1 FROM `memo_graph` " +
+		"JOIN `memo_relation` ON `memo_relation`.`memo_id` = `memo_graph`.`memo_id` OR `memo_relation`.`related_memo_id` = `memo_graph`.`memo_id` " +
+		"JOIN `memo` ON `memo`.`id` = CASE WHEN `memo_relation`.`memo_id` = `memo_graph`.`memo_id` THEN `memo_relation`.`related_memo_id` ELSE `memo_relation`.`memo_id` END " +
+		"WHERE " + strings.Join(where, " AND ") +
+		") SELECT `memo_id`, MIN(`depth`) FROM `memo_graph` GROUP BY `memo_id` ORDER BY MIN(`depth`), `memo_id`"
+	rows, err := d.db.QueryContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoGraphNode{}
+	for rows.Next() {
+		node := &store.MemoGraphNode{}
+		if err := rows.Scan(&node.MemoID, &node.Depth); err != nil {
+			return nil, err
+		}
+		list = append(list, node)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
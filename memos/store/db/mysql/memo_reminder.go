@@ -0,0 +1,129 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoReminder(ctx context.Context, create *store.MemoReminder) (*store.MemoReminder, error) {
+	fields := []string{"`memo_id`", "`creator_id`", "`task_position`", "`remind_ts`", "`fired_ts`"}
+	placeholder := []string{"?", "?", "?", "?", "?"}
+	args := []any{create.MemoID, create.CreatorID, create.TaskPosition, create.RemindTs, create.FiredTs}
+
+	stmt := "INSERT INTO `memo_reminder` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	create.ID = int32(id)
+	return create, nil
+}
+
+func (d *DB) ListMemoReminders(ctx context.Context, find *store.FindMemoReminder) ([]*store.MemoReminder, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.TaskPosition; v != nil {
+		where, args = append(where, "`task_position` = ?"), append(args, *v)
+	}
+	if v := find.HasTask; v != nil {
+		if *v {
+			where = append(where, "`task_position` IS NOT NULL")
+		} else {
+			where = append(where, "`task_position` IS NULL")
+		}
+	}
+	if v := find.Pending; v != nil {
+		if *v {
+			where = append(where, "`fired_ts` = 0")
+		} else {
+			where = append(where, "`fired_ts` != 0")
+		}
+	}
+	if v := find.RemindTsBefore; v != nil {
+		where, args = append(where, "`remind_ts` < ?"), append(args, *v)
+	}
+
+	query := "SELECT `id`, `memo_id`, `creator_id`, `task_position`, `remind_ts`, `fired_ts` FROM `memo_reminder` WHERE " + strings.Join(where, " AND ") + " ORDER BY `remind_ts` ASC, `id` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoReminder{}
+	for rows.Next() {
+		reminder := &store.MemoReminder{}
+		if err := rows.Scan(
+			&reminder.ID,
+			&reminder.MemoID,
+			&reminder.CreatorID,
+			&reminder.TaskPosition,
+			&reminder.RemindTs,
+			&reminder.FiredTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, reminder)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateMemoReminder(ctx context.Context, update *store.UpdateMemoReminder) (*store.MemoReminder, error) {
+	set, args := []string{}, []any{}
+	if v := update.TaskPosition; v != nil {
+		set, args = append(set, "`task_position` = ?"), append(args, *v)
+	}
+	if v := update.RemindTs; v != nil {
+		set, args = append(set, "`remind_ts` = ?"), append(args, *v)
+	}
+	if v := update.FiredTs; v != nil {
+		set, args = append(set, "`fired_ts` = ?"), append(args, *v)
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE `memo_reminder` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+	list, err := d.ListMemoReminders(ctx, &store.FindMemoReminder{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("memo reminder %d not found", update.ID)
+	}
+	return list[0], nil
+}
+
+func (d *DB) DeleteMemoReminder(ctx context.Context, delete *store.DeleteMemoReminder) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := delete.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `memo_reminder` WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
@@ -0,0 +1,94 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoShareLink(ctx context.Context, create *store.MemoShareLink) (*store.MemoShareLink, error) {
+	fields := []string{"`memo_id`", "`creator_id`", "`token`", "`password_hash`", "`expire_ts`"}
+	placeholder := []string{"?", "?", "?", "?", "?"}
+	args := []any{create.MemoID, create.CreatorID, create.Token, create.PasswordHash, create.ExpireTs}
+
+	stmt := "INSERT INTO `memo_share_link` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	id32 := int32(id)
+	list, err := d.ListMemoShareLinks(ctx, &store.FindMemoShareLink{ID: &id32})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("unexpected memo share link count: %d", len(list))
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListMemoShareLinks(ctx context.Context, find *store.FindMemoShareLink) ([]*store.MemoShareLink, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.Token; v != nil {
+		where, args = append(where, "`token` = ?"), append(args, *v)
+	}
+
+	query := "SELECT `id`, `memo_id`, `creator_id`, UNIX_TIMESTAMP(`created_ts`), `token`, `password_hash`, `expire_ts` FROM `memo_share_link` WHERE " + strings.Join(where, " AND ") + " ORDER BY `created_ts` DESC, `id` DESC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoShareLink{}
+	for rows.Next() {
+		link := &store.MemoShareLink{}
+		if err := rows.Scan(
+			&link.ID,
+			&link.MemoID,
+			&link.CreatorID,
+			&link.CreatedTs,
+			&link.Token,
+			&link.PasswordHash,
+			&link.ExpireTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, link)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoShareLink(ctx context.Context, delete *store.DeleteMemoShareLink) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := delete.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `memo_share_link` WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
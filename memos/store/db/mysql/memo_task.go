@@ -0,0 +1,104 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoTask(ctx context.Context, create *store.MemoTask) (*store.MemoTask, error) {
+	fields := []string{"`memo_id`", "`creator_id`", "`position`", "`content`", "`completed`", "`due_ts`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?"}
+	args := []any{create.MemoID, create.CreatorID, create.Position, create.Content, create.Completed, create.DueTs}
+
+	stmt := "INSERT INTO `memo_task` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	create.ID = int32(id)
+	return create, nil
+}
+
+func (d *DB) ListMemoTasks(ctx context.Context, find *store.FindMemoTask) ([]*store.MemoTask, error) {
+	where, args := []string{"`memo`.`deleted_ts` = 0"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`memo_task`.`id` = ?"), append(args, *v)
+	}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "`memo_task`.`memo_id` = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`memo_task`.`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.Position; v != nil {
+		where, args = append(where, "`memo_task`.`position` = ?"), append(args, *v)
+	}
+	if v := find.Completed; v != nil {
+		where, args = append(where, "`memo_task`.`completed` = ?"), append(args, *v)
+	}
+	if v := find.HasDue; v != nil {
+		if *v {
+			where = append(where, "`memo_task`.`due_ts` IS NOT NULL")
+		} else {
+			where = append(where, "`memo_task`.`due_ts` IS NULL")
+		}
+	}
+	if v := find.DueTsBefore; v != nil {
+		where, args = append(where, "`memo_task`.`due_ts` < ?"), append(args, *v)
+	}
+	if v := find.DueTsAfter; v != nil {
+		where, args = append(where, "`memo_task`.`due_ts` > ?"), append(args, *v)
+	}
+	for _, s := range find.ContentSearch {
+		where, args = append(where, "`memo_task`.`content` LIKE ?"), append(args, fmt.Sprintf("%%%s%%", s))
+	}
+	if v := find.MemoRowStatus; v != nil {
+		where, args = append(where, "`memo`.`row_status` = ?"), append(args, *v)
+	}
+
+	// Tasks with a due time come first, the earliest first.
+	query := "SELECT `memo_task`.`id`, `memo_task`.`memo_id`, `memo_task`.`creator_id`, `memo_task`.`position`, `memo_task`.`content`, `memo_task`.`completed`, `memo_task`.`due_ts` " +
+		"FROM `memo_task` JOIN `memo` ON `memo`.`id` = `memo_task`.`memo_id` " +
+		"WHERE " + strings.Join(where, " AND ") + " " +
+		"ORDER BY `memo_task`.`due_ts` IS NULL, `memo_task`.`due_ts` ASC, `memo`.`created_ts` DESC, `memo_task`.`position` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTask{}
+	for rows.Next() {
+		task := &store.MemoTask{}
+		if err := rows.Scan(
+			&task.ID,
+			&task.MemoID,
+			&task.CreatorID,
+			&task.Position,
+			&task.Content,
+			&task.Completed,
+			&task.DueTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoTask(ctx context.Context, delete *store.DeleteMemoTask) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `memo_task` WHERE `memo_id` = ?", delete.MemoID)
+	return err
+}
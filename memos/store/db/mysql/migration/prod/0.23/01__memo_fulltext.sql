@@ -0,0 +1 @@
+ALTER TABLE `memo` ADD FULLTEXT INDEX `idx_memo_content_fulltext` (`content`) WITH PARSER ngram;
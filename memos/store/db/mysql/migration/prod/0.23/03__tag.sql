@@ -0,0 +1,13 @@
+CREATE TABLE `tag` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `name` VARCHAR(256) NOT NULL,
+  `color` VARCHAR(256) NOT NULL DEFAULT '',
+  `emoji` VARCHAR(256) NOT NULL DEFAULT '',
+  `description` TEXT NOT NULL,
+  `pinned` INT NOT NULL DEFAULT '0',
+  `sort_order` INT NOT NULL DEFAULT '0',
+  UNIQUE(`creator_id`,`name`)
+);
@@ -0,0 +1,11 @@
+CREATE TABLE `memo_task` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `position` INT NOT NULL,
+  `content` TEXT NOT NULL,
+  `completed` INT NOT NULL DEFAULT '0',
+  `due_ts` BIGINT,
+  UNIQUE(`memo_id`,`position`),
+  INDEX `idx_memo_task_creator_id` (`creator_id`)
+);
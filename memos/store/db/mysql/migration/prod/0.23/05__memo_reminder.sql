@@ -0,0 +1,9 @@
+CREATE TABLE `memo_reminder` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `task_position` INT,
+  `remind_ts` BIGINT NOT NULL,
+  `fired_ts` BIGINT NOT NULL DEFAULT '0',
+  INDEX `idx_memo_reminder_remind_ts` (`fired_ts`,`remind_ts`)
+);
@@ -0,0 +1,10 @@
+CREATE TABLE `memo_share_link` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `token` VARCHAR(256) NOT NULL UNIQUE,
+  `password_hash` VARCHAR(256) NOT NULL DEFAULT '',
+  `expire_ts` BIGINT NOT NULL DEFAULT '0',
+  INDEX `idx_memo_share_link_memo_id` (`memo_id`)
+);
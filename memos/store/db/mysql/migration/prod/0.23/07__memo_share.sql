@@ -0,0 +1,10 @@
+CREATE TABLE `memo_share` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `permission` VARCHAR(256) NOT NULL DEFAULT 'READ',
+  UNIQUE(`memo_id`,`user_id`),
+  INDEX `idx_memo_share_user_id` (`user_id`)
+);
@@ -0,0 +1,12 @@
+CREATE TABLE `memo_template` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `scope` VARCHAR(256) NOT NULL DEFAULT 'USER',
+  `title` VARCHAR(256) NOT NULL DEFAULT '',
+  `content` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
+  `payload` JSON NOT NULL,
+  INDEX `idx_memo_template_creator_id` (`creator_id`)
+);
@@ -0,0 +1,12 @@
+CREATE TABLE `recurring_memo` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `state` VARCHAR(256) NOT NULL DEFAULT 'ACTIVE',
+  `cron_expression` VARCHAR(256) NOT NULL,
+  `timezone` VARCHAR(256) NOT NULL DEFAULT 'UTC',
+  `last_run_ts` BIGINT NOT NULL DEFAULT '0',
+  `payload` JSON NOT NULL,
+  INDEX `idx_recurring_memo_creator_id` (`creator_id`)
+);
@@ -0,0 +1,18 @@
+CREATE TABLE `collection` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `title` VARCHAR(256) NOT NULL,
+  `description` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
+  INDEX `idx_collection_creator_id` (`creator_id`)
+);
+
+CREATE TABLE `collection_memo` (
+  `collection_id` INT NOT NULL,
+  `memo_id` INT NOT NULL,
+  `position` INT NOT NULL DEFAULT '0',
+  UNIQUE(`collection_id`,`memo_id`),
+  INDEX `idx_collection_memo_memo_id` (`memo_id`)
+);
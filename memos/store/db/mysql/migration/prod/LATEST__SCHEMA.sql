@@ -45,7 +45,10 @@ CREATE TABLE `memo` (
   `content` TEXT NOT NULL,
   `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
   `tags` JSON NOT NULL,
-  `payload` JSON NOT NULL
+  `payload` JSON NOT NULL,
+  `deleted_ts` BIGINT NOT NULL DEFAULT 0,
+  FULLTEXT INDEX `idx_memo_content_fulltext` (`content`) WITH PARSER ngram,
+  INDEX `idx_memo_deleted_ts` (`deleted_ts`)
 );
 
 -- memo_organizer
@@ -78,7 +81,8 @@ CREATE TABLE `resource` (
   `memo_id` INT DEFAULT NULL,
   `storage_type` VARCHAR(256) NOT NULL DEFAULT '',
   `reference` VARCHAR(256) NOT NULL DEFAULT '',
-  `payload` TEXT NOT NULL
+  `payload` TEXT NOT NULL,
+  `deleted_ts` BIGINT NOT NULL DEFAULT 0
 );
 
 -- activity
@@ -130,3 +134,126 @@ CREATE TABLE `reaction` (
   `reaction_type` VARCHAR(256) NOT NULL,
   UNIQUE(`creator_id`,`content_id`,`reaction_type`)  
 );
+
+-- memo_revision
+CREATE TABLE `memo_revision` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `content` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
+  `payload` JSON NOT NULL,
+  INDEX `idx_memo_revision_memo_id` (`memo_id`)
+);
+
+-- tag
+CREATE TABLE `tag` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `name` VARCHAR(256) NOT NULL,
+  `color` VARCHAR(256) NOT NULL DEFAULT '',
+  `emoji` VARCHAR(256) NOT NULL DEFAULT '',
+  `description` TEXT NOT NULL,
+  `pinned` INT NOT NULL DEFAULT '0',
+  `sort_order` INT NOT NULL DEFAULT '0',
+  UNIQUE(`creator_id`,`name`)
+);
+
+-- memo_task
+CREATE TABLE `memo_task` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `position` INT NOT NULL,
+  `content` TEXT NOT NULL,
+  `completed` INT NOT NULL DEFAULT '0',
+  `due_ts` BIGINT,
+  UNIQUE(`memo_id`,`position`),
+  INDEX `idx_memo_task_creator_id` (`creator_id`)
+);
+
+-- memo_reminder
+CREATE TABLE `memo_reminder` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `task_position` INT,
+  `remind_ts` BIGINT NOT NULL,
+  `fired_ts` BIGINT NOT NULL DEFAULT '0',
+  INDEX `idx_memo_reminder_remind_ts` (`fired_ts`,`remind_ts`)
+);
+
+-- memo_share_link
+CREATE TABLE `memo_share_link` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `token` VARCHAR(256) NOT NULL UNIQUE,
+  `password_hash` VARCHAR(256) NOT NULL DEFAULT '',
+  `expire_ts` BIGINT NOT NULL DEFAULT '0',
+  INDEX `idx_memo_share_link_memo_id` (`memo_id`)
+);
+
+-- memo_share
+CREATE TABLE `memo_share` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `memo_id` INT NOT NULL,
+  `creator_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `permission` VARCHAR(256) NOT NULL DEFAULT 'READ',
+  UNIQUE(`memo_id`,`user_id`),
+  INDEX `idx_memo_share_user_id` (`user_id`)
+);
+
+-- memo_template
+CREATE TABLE `memo_template` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `scope` VARCHAR(256) NOT NULL DEFAULT 'USER',
+  `title` VARCHAR(256) NOT NULL DEFAULT '',
+  `content` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
+  `payload` JSON NOT NULL,
+  INDEX `idx_memo_template_creator_id` (`creator_id`)
+);
+
+-- recurring_memo
+CREATE TABLE `recurring_memo` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `state` VARCHAR(256) NOT NULL DEFAULT 'ACTIVE',
+  `cron_expression` VARCHAR(256) NOT NULL,
+  `timezone` VARCHAR(256) NOT NULL DEFAULT 'UTC',
+  `last_run_ts` BIGINT NOT NULL DEFAULT '0',
+  `payload` JSON NOT NULL,
+  INDEX `idx_recurring_memo_creator_id` (`creator_id`)
+);
+
+-- collection
+CREATE TABLE `collection` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `title` VARCHAR(256) NOT NULL,
+  `description` TEXT NOT NULL,
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
+  INDEX `idx_collection_creator_id` (`creator_id`)
+);
+
+CREATE TABLE `collection_memo` (
+  `collection_id` INT NOT NULL,
+  `memo_id` INT NOT NULL,
+  `position` INT NOT NULL DEFAULT '0',
+  UNIQUE(`collection_id`,`memo_id`),
+  INDEX `idx_collection_memo_memo_id` (`memo_id`)
+);
@@ -12,8 +12,17 @@ import (
 	"github.com/usememos/memos/store"
 )
 
+// executor runs statements on either a database or a transaction.
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
 type DB struct {
-	db      *sql.DB
+	sqlDB *sql.DB
+	// db is the database, or the transaction when the driver is created by WithTx.
+	db      executor
 	profile *profile.Profile
 	config  *mysql.Config
 }
@@ -33,16 +42,17 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 		return nil, errors.New("Parse DSN eroor")
 	}
 
-	driver.db, err = sql.Open("mysql", dsn)
+	driver.sqlDB, err = sql.Open("mysql", dsn)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to open db: %s", profile.DSN)
 	}
+	driver.db = driver.sqlDB
 
 	return &driver, nil
 }
 
 func (d *DB) GetDB() *sql.DB {
-	return d.db
+	return d.sqlDB
 }
 
 func (d *DB) GetCurrentDBSize(ctx context.Context) (int64, error) {
@@ -72,7 +82,29 @@ func (d *DB) GetCurrentDBSize(ctx context.Context) (int64, error) {
 }
 
 func (d *DB) Close() error {
-	return d.db.Close()
+	return d.sqlDB.Close()
+}
+
+// WithTx runs fn with a driver whose statements are executed within a transaction.
+// The transaction is committed if fn returns nil and rolled back otherwise.
+func (d *DB) WithTx(ctx context.Context, fn func(driver store.Driver) error) error {
+	// Join the current transaction if there is one.
+	if _, ok := d.db.(*sql.Tx); ok {
+		return fn(d)
+	}
+
+	tx, err := d.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	txDriver := *d
+	txDriver.db = tx
+	if err := fn(&txDriver); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
 
 func mergeDSN(baseDSN string) (string, error) {
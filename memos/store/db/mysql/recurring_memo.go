@@ -0,0 +1,143 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateRecurringMemo(ctx context.Context, create *store.RecurringMemo) (*store.RecurringMemo, error) {
+	payload := "{}"
+	if create.Payload != nil {
+		payloadBytes, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return nil, err
+		}
+		payload = string(payloadBytes)
+	}
+	fields := []string{"`creator_id`", "`state`", "`cron_expression`", "`timezone`", "`last_run_ts`", "`payload`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?"}
+	args := []any{create.CreatorID, create.State, create.CronExpression, create.Timezone, create.LastRunTs, payload}
+
+	stmt := "INSERT INTO `recurring_memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	id32 := int32(id)
+	list, err := d.ListRecurringMemos(ctx, &store.FindRecurringMemo{ID: &id32})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("unexpected recurring memo count: %d", len(list))
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListRecurringMemos(ctx context.Context, find *store.FindRecurringMemo) ([]*store.RecurringMemo, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.State; v != nil {
+		where, args = append(where, "`state` = ?"), append(args, *v)
+	}
+
+	query := "SELECT `id`, `creator_id`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`), `state`, `cron_expression`, `timezone`, `last_run_ts`, `payload` FROM `recurring_memo` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.RecurringMemo{}
+	for rows.Next() {
+		recurringMemo := &store.RecurringMemo{}
+		var payloadBytes []byte
+		if err := rows.Scan(
+			&recurringMemo.ID,
+			&recurringMemo.CreatorID,
+			&recurringMemo.CreatedTs,
+			&recurringMemo.UpdatedTs,
+			&recurringMemo.State,
+			&recurringMemo.CronExpression,
+			&recurringMemo.Timezone,
+			&recurringMemo.LastRunTs,
+			&payloadBytes,
+		); err != nil {
+			return nil, err
+		}
+		payload := &storepb.RecurringMemoPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal payload")
+		}
+		recurringMemo.Payload = payload
+		list = append(list, recurringMemo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateRecurringMemo(ctx context.Context, update *store.UpdateRecurringMemo) (*store.RecurringMemo, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = FROM_UNIXTIME(?)"), append(args, *v)
+	}
+	if v := update.State; v != nil {
+		set, args = append(set, "`state` = ?"), append(args, *v)
+	}
+	if v := update.LastRunTs; v != nil {
+		set, args = append(set, "`last_run_ts` = ?"), append(args, *v)
+	}
+	where := []string{"`id` = ?"}
+	args = append(args, update.ID)
+	if v := update.ExpectedLastRunTs; v != nil {
+		where, args = append(where, "`last_run_ts` = ?"), append(args, *v)
+	}
+
+	stmt := "UPDATE `recurring_memo` SET " + strings.Join(set, ", ") + " WHERE " + strings.Join(where, " AND ")
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	if update.ExpectedLastRunTs != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if rows == 0 {
+			return nil, store.ErrRecurringMemoChanged
+		}
+	}
+	list, err := d.ListRecurringMemos(ctx, &store.FindRecurringMemo{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("recurring memo %d not found", update.ID)
+	}
+	return list[0], nil
+}
+
+func (d *DB) DeleteRecurringMemo(ctx context.Context, delete *store.DeleteRecurringMemo) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `recurring_memo` WHERE `id` = ?", delete.ID)
+	return err
+}
@@ -0,0 +1,139 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateTag(ctx context.Context, create *store.Tag) (*store.Tag, error) {
+	pinned := 0
+	if create.Pinned {
+		pinned = 1
+	}
+	fields := []string{"`creator_id`", "`name`", "`color`", "`emoji`", "`description`", "`pinned`", "`sort_order`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?"}
+	args := []any{create.CreatorID, create.Name, create.Color, create.Emoji, create.Description, pinned, create.SortOrder}
+
+	stmt := "INSERT INTO `tag` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	id32 := int32(id)
+	return d.getTag(ctx, &store.FindTag{ID: &id32})
+}
+
+func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.Name; v != nil {
+		where, args = append(where, "`name` = ?"), append(args, *v)
+	}
+	if v := find.NameList; len(v) != 0 {
+		holders := []string{}
+		for _, name := range v {
+			holders = append(holders, "?")
+			args = append(args, name)
+		}
+		where = append(where, "`name` IN ("+strings.Join(holders, ", ")+")")
+	}
+
+	rows, err := d.db.QueryContext(ctx, "SELECT `id`, `creator_id`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`), `name`, `color`, `emoji`, `description`, `pinned`, `sort_order` FROM `tag` WHERE "+strings.Join(where, " AND ")+" ORDER BY `pinned` DESC, `sort_order` ASC, `name` ASC",
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Tag{}
+	for rows.Next() {
+		tag := &store.Tag{}
+		if err := rows.Scan(
+			&tag.ID,
+			&tag.CreatorID,
+			&tag.CreatedTs,
+			&tag.UpdatedTs,
+			&tag.Name,
+			&tag.Color,
+			&tag.Emoji,
+			&tag.Description,
+			&tag.Pinned,
+			&tag.SortOrder,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) getTag(ctx context.Context, find *store.FindTag) (*store.Tag, error) {
+	list, err := d.ListTags(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (d *DB) UpdateTag(ctx context.Context, update *store.UpdateTag) (*store.Tag, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = FROM_UNIXTIME(?)"), append(args, *v)
+	}
+	if v := update.Name; v != nil {
+		set, args = append(set, "`name` = ?"), append(args, *v)
+	}
+	if v := update.Color; v != nil {
+		set, args = append(set, "`color` = ?"), append(args, *v)
+	}
+	if v := update.Emoji; v != nil {
+		set, args = append(set, "`emoji` = ?"), append(args, *v)
+	}
+	if v := update.Description; v != nil {
+		set, args = append(set, "`description` = ?"), append(args, *v)
+	}
+	if v := update.Pinned; v != nil {
+		pinned := 0
+		if *v {
+			pinned = 1
+		}
+		set, args = append(set, "`pinned` = ?"), append(args, pinned)
+	}
+	if v := update.SortOrder; v != nil {
+		set, args = append(set, "`sort_order` = ?"), append(args, *v)
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE `tag` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+	return d.getTag(ctx, &store.FindTag{ID: &update.ID})
+}
+
+func (d *DB) DeleteTag(ctx context.Context, delete *store.DeleteTag) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `tag` WHERE `id` = ?", delete.ID)
+	return err
+}
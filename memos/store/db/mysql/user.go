@@ -85,6 +85,14 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 	if v := find.ID; v != nil {
 		where, args = append(where, "`id` = ?"), append(args, *v)
 	}
+	if v := find.IDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, "`id` IN ("+strings.Join(holders, ", ")+")")
+	}
 	if v := find.Username; v != nil {
 		where, args = append(where, "`username` = ?"), append(args, *v)
 	}
@@ -0,0 +1,161 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateCollection(ctx context.Context, create *store.Collection) (*store.Collection, error) {
+	fields := []string{"creator_id", "title", "description", "visibility"}
+	args := []any{create.CreatorID, create.Title, create.Description, create.Visibility}
+
+	stmt := "INSERT INTO collection (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID, &create.CreatedTs, &create.UpdatedTs); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListCollections(ctx context.Context, find *store.FindCollection) ([]*store.Collection, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.VisibilityList; len(v) != 0 {
+		holders := []string{}
+		for _, visibility := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, visibility.String())
+		}
+		where = append(where, fmt.Sprintf("visibility IN (%s)", strings.Join(holders, ", ")))
+	}
+
+	query := "SELECT id, creator_id, created_ts, updated_ts, title, description, visibility FROM collection WHERE " + strings.Join(where, " AND ") + " ORDER BY updated_ts DESC, id DESC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Collection{}
+	for rows.Next() {
+		collection := &store.Collection{}
+		if err := rows.Scan(
+			&collection.ID,
+			&collection.CreatorID,
+			&collection.CreatedTs,
+			&collection.UpdatedTs,
+			&collection.Title,
+			&collection.Description,
+			&collection.Visibility,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, collection)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateCollection(ctx context.Context, update *store.UpdateCollection) (*store.Collection, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Title; v != nil {
+		set, args = append(set, "title = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Description; v != nil {
+		set, args = append(set, "description = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Visibility; v != nil {
+		set, args = append(set, "visibility = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE collection SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args))
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+	list, err := d.ListCollections(ctx, &store.FindCollection{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("collection %d not found", update.ID)
+	}
+	return list[0], nil
+}
+
+func (d *DB) DeleteCollection(ctx context.Context, delete *store.DeleteCollection) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM collection WHERE id = "+placeholder(1), delete.ID)
+	return err
+}
+
+func (d *DB) UpsertCollectionMemo(ctx context.Context, upsert *store.CollectionMemo) (*store.CollectionMemo, error) {
+	stmt := "INSERT INTO collection_memo (collection_id, memo_id, position) VALUES (" + placeholders(3) + ") ON CONFLICT(collection_id, memo_id) DO UPDATE SET position = EXCLUDED.position"
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.CollectionID, upsert.MemoID, upsert.Position); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListCollectionMemos(ctx context.Context, find *store.FindCollectionMemo) ([]*store.CollectionMemo, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.CollectionID; v != nil {
+		where, args = append(where, "collection_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := "SELECT collection_id, memo_id, position FROM collection_memo WHERE " + strings.Join(where, " AND ") + " ORDER BY collection_id ASC, position ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.CollectionMemo{}
+	for rows.Next() {
+		collectionMemo := &store.CollectionMemo{}
+		if err := rows.Scan(
+			&collectionMemo.CollectionID,
+			&collectionMemo.MemoID,
+			&collectionMemo.Position,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, collectionMemo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteCollectionMemo(ctx context.Context, delete *store.DeleteCollectionMemo) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.CollectionID; v != nil {
+		where, args = append(where, "collection_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := delete.MemoID; v != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM collection_memo WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
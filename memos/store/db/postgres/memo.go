@@ -43,9 +43,25 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.ID; v != nil {
 		where, args = append(where, "memo.id = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.IDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, id)
+		}
+		where = append(where, "memo.id IN ("+strings.Join(holders, ", ")+")")
+	}
 	if v := find.UID; v != nil {
 		where, args = append(where, "memo.uid = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.UIDList; len(v) != 0 {
+		holders := []string{}
+		for _, uid := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, uid)
+		}
+		where = append(where, "memo.uid IN ("+strings.Join(holders, ", ")+")")
+	}
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, "memo.creator_id = "+placeholder(len(args)+1)), append(args, *v)
 	}
@@ -64,11 +80,24 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.UpdatedTsAfter; v != nil {
 		where, args = append(where, "memo.updated_ts > "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if find.Trashed {
+		where = append(where, "memo.deleted_ts > 0")
+	} else if !find.IncludeTrashed {
+		where = append(where, "memo.deleted_ts = 0")
+	}
+	if v := find.DeletedTsBefore; v != nil {
+		where, args = append(where, "memo.deleted_ts < "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if v := find.ContentSearch; len(v) != 0 {
 		for _, s := range v {
 			where, args = append(where, "memo.content LIKE "+placeholder(len(args)+1)), append(args, fmt.Sprintf("%%%s%%", s))
 		}
 	}
+	relevance := ""
+	if v := find.ContentQuery; v != nil {
+		where, args = append(where, "memo.content_tsv @@ plainto_tsquery('simple', "+placeholder(len(args)+1)+")"), append(args, *v)
+		relevance = "ts_rank(memo.content_tsv, plainto_tsquery('simple', " + placeholder(len(args)) + ")) DESC"
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		holders := []string{}
 		for _, visibility := range v {
@@ -97,11 +126,41 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			where = append(where, "(memo.payload->'property'->>'hasIncompleteTasks')::BOOLEAN IS TRUE")
 		}
 	}
+	if v := find.Filter; v != nil {
+		condition, newArgs, err := renderMemoFilter(v, args)
+		if err != nil {
+			return nil, err
+		}
+		where, args = append(where, condition), newArgs
+	}
+	if v := find.ParentID; v != nil {
+		where, args = append(where, "memo_relation.related_memo_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if find.ExcludeComments {
 		where = append(where, "memo_relation.related_memo_id IS NULL")
 	}
+	if v := find.Cursor; v != nil {
+		displayTsColumn := "memo.created_ts"
+		if find.OrderByUpdatedTs {
+			displayTsColumn = "memo.updated_ts"
+		}
+		condition := fmt.Sprintf("(%s < %s OR (%s = %s AND memo.id < %s))", displayTsColumn, placeholder(len(args)+1), displayTsColumn, placeholder(len(args)+1), placeholder(len(args)+2))
+		args = append(args, v.DisplayTs, v.ID)
+		if find.OrderByPinned {
+			pinned := 0
+			if v.Pinned {
+				pinned = 1
+			}
+			condition = fmt.Sprintf("(COALESCE(memo_organizer.pinned, 0) < %s OR (COALESCE(memo_organizer.pinned, 0) = %s AND %s))", placeholder(len(args)+1), placeholder(len(args)+1), condition)
+			args = append(args, pinned)
+		}
+		where = append(where, condition)
+	}
 
 	orders := []string{}
+	if find.OrderByRelevance && relevance != "" {
+		orders = append(orders, relevance)
+	}
 	if find.OrderByPinned {
 		orders = append(orders, "pinned DESC")
 	}
@@ -121,6 +180,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		`memo.creator_id AS creator_id`,
 		`memo.created_ts AS created_ts`,
 		`memo.updated_ts AS updated_ts`,
+		`memo.deleted_ts AS deleted_ts`,
 		`memo.row_status AS row_status`,
 		`memo.visibility AS visibility`,
 		`memo.payload AS payload`,
@@ -160,6 +220,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.CreatorID,
 			&memo.CreatedTs,
 			&memo.UpdatedTs,
+			&memo.DeletedTs,
 			&memo.RowStatus,
 			&memo.Visibility,
 			&payloadBytes,
@@ -211,6 +272,9 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if v := update.UpdatedTs; v != nil {
 		set, args = append(set, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := update.DeletedTs; v != nil {
+		set, args = append(set, "deleted_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if v := update.RowStatus; v != nil {
 		set, args = append(set, "row_status = "+placeholder(len(args)+1)), append(args, *v)
 	}
@@ -228,11 +292,26 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 		set, args = append(set, "payload = "+placeholder(len(args)+1)), append(args, string(payloadBytes))
 	}
 
-	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE id = ` + placeholder(len(args)+1)
+	where := []string{"id = " + placeholder(len(args)+1)}
 	args = append(args, update.ID)
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	if v := update.ExpectedUpdatedTs; v != nil {
+		where, args = append(where, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	stmt := `UPDATE memo SET ` + strings.Join(set, ", ") + ` WHERE ` + strings.Join(where, " AND ")
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
 		return err
 	}
+	if update.ExpectedUpdatedTs != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrMemoChanged
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// likePatternEscaper escapes the wildcards of LIKE patterns, which are matched with `\` as the escape character.
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+var memoFilterColumns = map[store.MemoFilterField]string{
+	store.MemoFilterFieldContent:    "memo.content",
+	store.MemoFilterFieldVisibility: "memo.visibility",
+	store.MemoFilterFieldCreatorID:  "memo.creator_id",
+	store.MemoFilterFieldUID:        "memo.uid",
+	store.MemoFilterFieldRowStatus:  "memo.row_status",
+	store.MemoFilterFieldCreatedTs:  "memo.created_ts",
+	store.MemoFilterFieldUpdatedTs:  "memo.updated_ts",
+	store.MemoFilterFieldPublishTs:  "COALESCE((memo.payload->'schedule'->>'publishTs')::BIGINT, 0)",
+	store.MemoFilterFieldExpireTs:   "COALESCE((memo.payload->'expiration'->>'expireTs')::BIGINT, 0)",
+}
+
+var memoFilterPayloadProperties = map[store.MemoFilterField]string{
+	store.MemoFilterFieldHasLink:            "hasLink",
+	store.MemoFilterFieldHasTaskList:        "hasTaskList",
+	store.MemoFilterFieldHasCode:            "hasCode",
+	store.MemoFilterFieldHasIncompleteTasks: "hasIncompleteTasks",
+}
+
+// renderMemoFilter renders the memo filter to a SQL condition and appends its arguments to args.
+func renderMemoFilter(filter store.MemoFilter, args []any) (string, []any, error) {
+	switch f := filter.(type) {
+	case *store.MemoFilterAnd:
+		return renderMemoFilterList(f.Filters, " AND ", "1 = 1", args)
+	case *store.MemoFilterOr:
+		return renderMemoFilterList(f.Filters, " OR ", "1 = 0", args)
+	case *store.MemoFilterNot:
+		condition, args, err := renderMemoFilter(f.Filter, args)
+		if err != nil {
+			return "", nil, err
+		}
+		return "NOT (" + condition + ")", args, nil
+	case *store.MemoFilterCondition:
+		return renderMemoFilterCondition(f, args)
+	default:
+		return "", nil, errors.Errorf("unsupported memo filter %T", filter)
+	}
+}
+
+func renderMemoFilterList(filters []store.MemoFilter, separator, empty string, args []any) (string, []any, error) {
+	if len(filters) == 0 {
+		return empty, args, nil
+	}
+	conditions := []string{}
+	for _, filter := range filters {
+		condition, newArgs, err := renderMemoFilter(filter, args)
+		if err != nil {
+			return "", nil, err
+		}
+		conditions, args = append(conditions, condition), newArgs
+	}
+	return "(" + strings.Join(conditions, separator) + ")", args, nil
+}
+
+func renderMemoFilterCondition(condition *store.MemoFilterCondition, args []any) (string, []any, error) {
+	if column, ok := memoFilterColumns[condition.Field]; ok {
+		switch condition.Operator {
+		case store.MemoFilterOperatorEqual, store.MemoFilterOperatorNotEqual,
+			store.MemoFilterOperatorLess, store.MemoFilterOperatorLessOrEqual,
+			store.MemoFilterOperatorGreater, store.MemoFilterOperatorGreaterOrEqual:
+			return fmt.Sprintf("%s %s %s", column, condition.Operator, placeholder(len(args)+1)), append(args, condition.Value), nil
+		case store.MemoFilterOperatorIn:
+			values, ok := condition.Value.([]any)
+			if !ok || len(values) == 0 {
+				return "", nil, errors.Errorf("invalid values for %s", condition.Field)
+			}
+			holders := []string{}
+			for _, value := range values {
+				holders, args = append(holders, placeholder(len(args)+1)), append(args, value)
+			}
+			return fmt.Sprintf("%s IN (%s)", column, strings.Join(holders, ", ")), args, nil
+		case store.MemoFilterOperatorStartsWith:
+			return column + " LIKE " + placeholder(len(args)+1) + " ESCAPE '\\'", append(args, fmt.Sprintf("%s%%", likePatternEscaper.Replace(fmt.Sprint(condition.Value)))), nil
+		case store.MemoFilterOperatorContains:
+			return column + " LIKE " + placeholder(len(args)+1) + " ESCAPE '\\'", append(args, fmt.Sprintf("%%%s%%", likePatternEscaper.Replace(fmt.Sprint(condition.Value)))), nil
+		}
+	} else if property, ok := memoFilterPayloadProperties[condition.Field]; ok {
+		value, ok := condition.Value.(bool)
+		if ok && (condition.Operator == store.MemoFilterOperatorEqual || condition.Operator == store.MemoFilterOperatorNotEqual) {
+			if value == (condition.Operator == store.MemoFilterOperatorNotEqual) {
+				return fmt.Sprintf("(memo.payload->'property'->>'%s')::BOOLEAN IS NOT TRUE", property), args, nil
+			}
+			return fmt.Sprintf("(memo.payload->'property'->>'%s')::BOOLEAN IS TRUE", property), args, nil
+		}
+	} else if condition.Field == store.MemoFilterFieldTag {
+		switch condition.Operator {
+		case store.MemoFilterOperatorEqual:
+			tags, err := json.Marshal([]any{condition.Value})
+			if err != nil {
+				return "", nil, err
+			}
+			return "memo.payload->'property'->'tags' @> " + placeholder(len(args)+1), append(args, string(tags)), nil
+		case store.MemoFilterOperatorStartsWith:
+			return "EXISTS (SELECT 1 FROM jsonb_array_elements_text(memo.payload->'property'->'tags') AS tag WHERE tag LIKE " + placeholder(len(args)+1) + " ESCAPE '\\')", append(args, fmt.Sprintf("%s%%", likePatternEscaper.Replace(fmt.Sprint(condition.Value)))), nil
+		case store.MemoFilterOperatorContains:
+			return "EXISTS (SELECT 1 FROM jsonb_array_elements_text(memo.payload->'property'->'tags') AS tag WHERE tag LIKE " + placeholder(len(args)+1) + " ESCAPE '\\')", append(args, fmt.Sprintf("%%%s%%", likePatternEscaper.Replace(fmt.Sprint(condition.Value)))), nil
+		case store.MemoFilterOperatorSubtree:
+			return "EXISTS (SELECT 1 FROM jsonb_array_elements_text(memo.payload->'property'->'tags') AS tag WHERE tag = " + placeholder(len(args)+1) + " OR tag LIKE " + placeholder(len(args)+2) + " ESCAPE '\\')", append(args, condition.Value, fmt.Sprintf("%s/%%", likePatternEscaper.Replace(fmt.Sprint(condition.Value)))), nil
+		}
+	} else if condition.Field == store.MemoFilterFieldSharedWith && condition.Operator == store.MemoFilterOperatorEqual {
+		return "memo.id IN (SELECT memo_id FROM memo_share WHERE user_id = " + placeholder(len(args)+1) + ")", append(args, condition.Value), nil
+	} else if condition.Field == store.MemoFilterFieldCollectionID && condition.Operator == store.MemoFilterOperatorEqual {
+		return "memo.id IN (SELECT memo_id FROM collection_memo WHERE collection_id = " + placeholder(len(args)+1) + ")", append(args, condition.Value), nil
+	} else if condition.Field == store.MemoFilterFieldCustomProperty {
+		return renderMemoFilterCustomPropertyCondition(condition, args)
+	}
+	return "", nil, errors.Errorf("unsupported operator %s for %s", condition.Operator, condition.Field)
+}
+
+// renderMemoFilterCustomPropertyCondition compares the value of the custom property with the value of the same type.
+func renderMemoFilterCustomPropertyCondition(condition *store.MemoFilterCondition, args []any) (string, []any, error) {
+	switch condition.Operator {
+	case store.MemoFilterOperatorEqual, store.MemoFilterOperatorNotEqual,
+		store.MemoFilterOperatorLess, store.MemoFilterOperatorLessOrEqual,
+		store.MemoFilterOperatorGreater, store.MemoFilterOperatorGreaterOrEqual:
+	default:
+		return "", nil, errors.Errorf("unsupported operator %s for %s", condition.Operator, condition.Field)
+	}
+	property := "memo.payload->'customProperties'->" + placeholder(len(args)+1) + "::TEXT"
+	switch condition.Value.(type) {
+	case string:
+		return fmt.Sprintf("%s->>'stringValue' %s %s", property, condition.Operator, placeholder(len(args)+2)), append(args, condition.Key, condition.Value), nil
+	case float64:
+		return fmt.Sprintf("(%s->>'numberValue')::DOUBLE PRECISION %s %s", property, condition.Operator, placeholder(len(args)+2)), append(args, condition.Key, condition.Value), nil
+	case bool:
+		return fmt.Sprintf("(%s->>'boolValue')::BOOLEAN %s %s", property, condition.Operator, placeholder(len(args)+2)), append(args, condition.Key, condition.Value), nil
+	default:
+		return "", nil, errors.Errorf("invalid value for %s", condition.Field)
+	}
+}
@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoShare(ctx context.Context, upsert *store.MemoShare) (*store.MemoShare, error) {
+	stmt := "INSERT INTO memo_share (memo_id, creator_id, user_id, permission) VALUES (" + placeholders(4) + ") ON CONFLICT(memo_id, user_id) DO UPDATE SET permission = EXCLUDED.permission RETURNING id, memo_id, creator_id, user_id, created_ts, permission"
+	share := &store.MemoShare{}
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.MemoID, upsert.CreatorID, upsert.UserID, upsert.Permission).Scan(
+		&share.ID,
+		&share.MemoID,
+		&share.CreatorID,
+		&share.UserID,
+		&share.CreatedTs,
+		&share.Permission,
+	); err != nil {
+		return nil, err
+	}
+	return share, nil
+}
+
+func (d *DB) ListMemoShares(ctx context.Context, find *store.FindMemoShare) ([]*store.MemoShare, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := "SELECT id, memo_id, creator_id, user_id, created_ts, permission FROM memo_share WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts ASC, id ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoShare{}
+	for rows.Next() {
+		share := &store.MemoShare{}
+		if err := rows.Scan(
+			&share.ID,
+			&share.MemoID,
+			&share.CreatorID,
+			&share.UserID,
+			&share.CreatedTs,
+			&share.Permission,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, share)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoShare(ctx context.Context, delete *store.DeleteMemoShare) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := delete.MemoID; v != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM memo_share WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
@@ -0,0 +1,76 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoShareLink(ctx context.Context, create *store.MemoShareLink) (*store.MemoShareLink, error) {
+	fields := []string{"memo_id", "creator_id", "token", "password_hash", "expire_ts"}
+	args := []any{create.MemoID, create.CreatorID, create.Token, create.PasswordHash, create.ExpireTs}
+	stmt := "INSERT INTO memo_share_link (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID, &create.CreatedTs); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListMemoShareLinks(ctx context.Context, find *store.FindMemoShareLink) ([]*store.MemoShareLink, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Token; v != nil {
+		where, args = append(where, "token = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := "SELECT id, memo_id, creator_id, created_ts, token, password_hash, expire_ts FROM memo_share_link WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC, id DESC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoShareLink{}
+	for rows.Next() {
+		link := &store.MemoShareLink{}
+		if err := rows.Scan(
+			&link.ID,
+			&link.MemoID,
+			&link.CreatorID,
+			&link.CreatedTs,
+			&link.Token,
+			&link.PasswordHash,
+			&link.ExpireTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, link)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoShareLink(ctx context.Context, delete *store.DeleteMemoShareLink) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := delete.MemoID; v != nil {
+		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	_, err := d.db.ExecContext(ctx, "DELETE FROM memo_share_link WHERE "+strings.Join(where, " AND "), args...)
+	return err
+}
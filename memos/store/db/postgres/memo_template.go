@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoTemplate(ctx context.Context, create *store.MemoTemplate) (*store.MemoTemplate, error) {
+	payload := "{}"
+	if create.Payload != nil {
+		payloadBytes, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return nil, err
+		}
+		payload = string(payloadBytes)
+	}
+	fields := []string{"creator_id", "scope", "title", "content", "visibility", "payload"}
+	args := []any{create.CreatorID, create.Scope, create.Title, create.Content, create.Visibility, payload}
+
+	stmt := "INSERT INTO memo_template (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID, &create.CreatedTs, &create.UpdatedTs); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListMemoTemplates(ctx context.Context, find *store.FindMemoTemplate) ([]*store.MemoTemplate, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Scope; v != nil {
+		where, args = append(where, "scope = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := "SELECT id, creator_id, created_ts, updated_ts, scope, title, content, visibility, payload FROM memo_template WHERE " + strings.Join(where, " AND ") + " ORDER BY title ASC, id ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTemplate{}
+	for rows.Next() {
+		template := &store.MemoTemplate{}
+		var payloadBytes []byte
+		if err := rows.Scan(
+			&template.ID,
+			&template.CreatorID,
+			&template.CreatedTs,
+			&template.UpdatedTs,
+			&template.Scope,
+			&template.Title,
+			&template.Content,
+			&template.Visibility,
+			&payloadBytes,
+		); err != nil {
+			return nil, err
+		}
+		payload := &storepb.MemoTemplatePayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal payload")
+		}
+		template.Payload = payload
+		list = append(list, template)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateMemoTemplate(ctx context.Context, update *store.UpdateMemoTemplate) (*store.MemoTemplate, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Title; v != nil {
+		set, args = append(set, "title = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Content; v != nil {
+		set, args = append(set, "content = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Visibility; v != nil {
+		set, args = append(set, "visibility = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Payload; v != nil {
+		payloadBytes, err := protojson.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		set, args = append(set, "payload = "+placeholder(len(args)+1)), append(args, string(payloadBytes))
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE memo_template SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args))
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+	list, err := d.ListMemoTemplates(ctx, &store.FindMemoTemplate{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("memo template %d not found", update.ID)
+	}
+	return list[0], nil
+}
+
+func (d *DB) DeleteMemoTemplate(ctx context.Context, delete *store.DeleteMemoTemplate) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM memo_template WHERE id = "+placeholder(1), delete.ID)
+	return err
+}
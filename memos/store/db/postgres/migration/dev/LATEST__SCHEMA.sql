@@ -45,9 +45,15 @@ CREATE TABLE memo (
   content TEXT NOT NULL,
   visibility TEXT NOT NULL DEFAULT 'PRIVATE',
   tags JSONB NOT NULL DEFAULT '[]',
-  payload JSONB NOT NULL DEFAULT '{}'
+  payload JSONB NOT NULL DEFAULT '{}',
+  deleted_ts BIGINT NOT NULL DEFAULT 0,
+  content_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED
 );
 
+CREATE INDEX idx_memo_content_tsv ON memo USING GIN (content_tsv);
+
+CREATE INDEX idx_memo_deleted_ts ON memo (deleted_ts);
+
 -- memo_organizer
 CREATE TABLE memo_organizer (
   memo_id INTEGER NOT NULL,
@@ -78,7 +84,8 @@ CREATE TABLE resource (
   memo_id INTEGER DEFAULT NULL,
   storage_type TEXT NOT NULL DEFAULT '',
   reference TEXT NOT NULL DEFAULT '',
-  payload TEXT NOT NULL DEFAULT '{}'
+  payload TEXT NOT NULL DEFAULT '{}',
+  deleted_ts BIGINT NOT NULL DEFAULT 0
 );
 
 -- activity
@@ -130,3 +137,135 @@ CREATE TABLE reaction (
   reaction_type TEXT NOT NULL,
   UNIQUE(creator_id, content_id, reaction_type)
 );
+
+-- memo_revision
+CREATE TABLE memo_revision (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  content TEXT NOT NULL,
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE',
+  payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_memo_revision_memo_id ON memo_revision (memo_id);
+
+-- tag
+CREATE TABLE tag (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL,
+  color TEXT NOT NULL DEFAULT '',
+  emoji TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  pinned INTEGER NOT NULL DEFAULT 0,
+  sort_order INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(creator_id, name)
+);
+
+-- memo_task
+CREATE TABLE memo_task (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  position INTEGER NOT NULL,
+  content TEXT NOT NULL DEFAULT '',
+  completed INTEGER NOT NULL DEFAULT 0,
+  due_ts BIGINT,
+  UNIQUE(memo_id, position)
+);
+
+CREATE INDEX idx_memo_task_creator_id ON memo_task (creator_id);
+
+-- memo_reminder
+CREATE TABLE memo_reminder (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  task_position INTEGER,
+  remind_ts BIGINT NOT NULL,
+  fired_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_memo_reminder_remind_ts ON memo_reminder (fired_ts, remind_ts);
+
+-- memo_share_link
+CREATE TABLE memo_share_link (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  token TEXT NOT NULL UNIQUE,
+  password_hash TEXT NOT NULL DEFAULT '',
+  expire_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_memo_share_link_memo_id ON memo_share_link (memo_id);
+
+-- memo_share
+CREATE TABLE memo_share (
+  id SERIAL PRIMARY KEY,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  permission TEXT NOT NULL DEFAULT 'READ',
+  UNIQUE(memo_id, user_id)
+);
+
+CREATE INDEX idx_memo_share_user_id ON memo_share (user_id);
+
+-- memo_template
+CREATE TABLE memo_template (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  scope TEXT NOT NULL DEFAULT 'USER',
+  title TEXT NOT NULL DEFAULT '',
+  content TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE',
+  payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_memo_template_creator_id ON memo_template (creator_id);
+
+-- recurring_memo
+CREATE TABLE recurring_memo (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  state TEXT NOT NULL DEFAULT 'ACTIVE',
+  cron_expression TEXT NOT NULL,
+  timezone TEXT NOT NULL DEFAULT 'UTC',
+  last_run_ts BIGINT NOT NULL DEFAULT 0,
+  payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_recurring_memo_creator_id ON recurring_memo (creator_id);
+
+-- collection
+CREATE TABLE collection (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  title TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE'
+);
+
+CREATE INDEX idx_collection_creator_id ON collection (creator_id);
+
+CREATE TABLE collection_memo (
+  collection_id INTEGER NOT NULL,
+  memo_id INTEGER NOT NULL,
+  position INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(collection_id, memo_id)
+);
+
+CREATE INDEX idx_collection_memo_memo_id ON collection_memo (memo_id);
@@ -0,0 +1,3 @@
+ALTER TABLE memo ADD COLUMN content_tsv TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED;
+
+CREATE INDEX idx_memo_content_tsv ON memo USING GIN (content_tsv);
@@ -0,0 +1,5 @@
+ALTER TABLE memo ADD COLUMN deleted_ts BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_memo_deleted_ts ON memo (deleted_ts);
+
+ALTER TABLE resource ADD COLUMN deleted_ts BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,13 @@
+CREATE TABLE recurring_memo (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  state TEXT NOT NULL DEFAULT 'ACTIVE',
+  cron_expression TEXT NOT NULL,
+  timezone TEXT NOT NULL DEFAULT 'UTC',
+  last_run_ts BIGINT NOT NULL DEFAULT 0,
+  payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_recurring_memo_creator_id ON recurring_memo (creator_id);
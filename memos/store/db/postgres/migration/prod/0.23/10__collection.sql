@@ -0,0 +1,20 @@
+CREATE TABLE collection (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  title TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE'
+);
+
+CREATE INDEX idx_collection_creator_id ON collection (creator_id);
+
+CREATE TABLE collection_memo (
+  collection_id INTEGER NOT NULL,
+  memo_id INTEGER NOT NULL,
+  position INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(collection_id, memo_id)
+);
+
+CREATE INDEX idx_collection_memo_memo_id ON collection_memo (memo_id);
@@ -13,8 +13,17 @@ import (
 	"github.com/usememos/memos/store"
 )
 
+// executor runs statements on either a database or a transaction.
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
 type DB struct {
-	db      *sql.DB
+	sqlDB *sql.DB
+	// db is the database, or the transaction when the driver is created by WithTx.
+	db      executor
 	profile *profile.Profile
 	// Add any other fields as needed
 }
@@ -32,6 +41,7 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 	}
 
 	var driver store.Driver = &DB{
+		sqlDB:   db,
 		db:      db,
 		profile: profile,
 	}
@@ -41,7 +51,7 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 }
 
 func (d *DB) GetDB() *sql.DB {
-	return d.db
+	return d.sqlDB
 }
 
 func (*DB) GetCurrentDBSize(context.Context) (int64, error) {
@@ -49,5 +59,27 @@ func (*DB) GetCurrentDBSize(context.Context) (int64, error) {
 }
 
 func (d *DB) Close() error {
-	return d.db.Close()
+	return d.sqlDB.Close()
+}
+
+// WithTx runs fn with a driver whose statements are executed within a transaction.
+// The transaction is committed if fn returns nil and rolled back otherwise.
+func (d *DB) WithTx(ctx context.Context, fn func(driver store.Driver) error) error {
+	// Join the current transaction if there is one.
+	if _, ok := d.db.(*sql.Tx); ok {
+		return fn(d)
+	}
+
+	tx, err := d.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	txDriver := *d
+	txDriver.db = tx
+	if err := fn(&txDriver); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
@@ -34,6 +34,14 @@ func (d *DB) ListReactions(ctx context.Context, find *store.FindReaction) ([]*st
 	if find.ContentID != nil {
 		where, args = append(where, "content_id = "+placeholder(len(args)+1)), append(args, *find.ContentID)
 	}
+	if v := find.ContentIDList; len(v) != 0 {
+		holders := []string{}
+		for _, contentID := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, contentID)
+		}
+		where = append(where, "content_id IN ("+strings.Join(holders, ", ")+")")
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateRecurringMemo(ctx context.Context, create *store.RecurringMemo) (*store.RecurringMemo, error) {
+	payload := "{}"
+	if create.Payload != nil {
+		payloadBytes, err := protojson.Marshal(create.Payload)
+		if err != nil {
+			return nil, err
+		}
+		payload = string(payloadBytes)
+	}
+	fields := []string{"creator_id", "state", "cron_expression", "timezone", "last_run_ts", "payload"}
+	args := []any{create.CreatorID, create.State, create.CronExpression, create.Timezone, create.LastRunTs, payload}
+
+	stmt := "INSERT INTO recurring_memo (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(&create.ID, &create.CreatedTs, &create.UpdatedTs); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListRecurringMemos(ctx context.Context, find *store.FindRecurringMemo) ([]*store.RecurringMemo, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.State; v != nil {
+		where, args = append(where, "state = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := "SELECT id, creator_id, created_ts, updated_ts, state, cron_expression, timezone, last_run_ts, payload FROM recurring_memo WHERE " + strings.Join(where, " AND ") + " ORDER BY id ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.RecurringMemo{}
+	for rows.Next() {
+		recurringMemo := &store.RecurringMemo{}
+		var payloadBytes []byte
+		if err := rows.Scan(
+			&recurringMemo.ID,
+			&recurringMemo.CreatorID,
+			&recurringMemo.CreatedTs,
+			&recurringMemo.UpdatedTs,
+			&recurringMemo.State,
+			&recurringMemo.CronExpression,
+			&recurringMemo.Timezone,
+			&recurringMemo.LastRunTs,
+			&payloadBytes,
+		); err != nil {
+			return nil, err
+		}
+		payload := &storepb.RecurringMemoPayload{}
+		if err := protojsonUnmarshaler.Unmarshal(payloadBytes, payload); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal payload")
+		}
+		recurringMemo.Payload = payload
+		list = append(list, recurringMemo)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateRecurringMemo(ctx context.Context, update *store.UpdateRecurringMemo) (*store.RecurringMemo, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.State; v != nil {
+		set, args = append(set, "state = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.LastRunTs; v != nil {
+		set, args = append(set, "last_run_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	where := []string{"id = " + placeholder(len(args)+1)}
+	args = append(args, update.ID)
+	if v := update.ExpectedLastRunTs; v != nil {
+		where, args = append(where, "last_run_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	stmt := "UPDATE recurring_memo SET " + strings.Join(set, ", ") + " WHERE " + strings.Join(where, " AND ")
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	if update.ExpectedLastRunTs != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if rows == 0 {
+			return nil, store.ErrRecurringMemoChanged
+		}
+	}
+	list, err := d.ListRecurringMemos(ctx, &store.FindRecurringMemo{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, errors.Errorf("recurring memo %d not found", update.ID)
+	}
+	return list[0], nil
+}
+
+func (d *DB) DeleteRecurringMemo(ctx context.Context, delete *store.DeleteRecurringMemo) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM recurring_memo WHERE id = "+placeholder(1), delete.ID)
+	return err
+}
@@ -54,14 +54,30 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 	if v := find.MemoID; v != nil {
 		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.MemoIDList; len(v) != 0 {
+		holders := []string{}
+		for _, memoID := range v {
+			holders = append(holders, placeholder(len(args)+1))
+			args = append(args, memoID)
+		}
+		where = append(where, "memo_id IN ("+strings.Join(holders, ", ")+")")
+	}
 	if find.HasRelatedMemo {
 		where = append(where, "memo_id IS NOT NULL")
 	}
 	if v := find.StorageType; v != nil {
 		where, args = append(where, "storage_type = "+placeholder(len(args)+1)), append(args, v.String())
 	}
+	if find.Trashed {
+		where = append(where, "deleted_ts > 0")
+	} else if !find.IncludeTrashed {
+		where = append(where, "deleted_ts = 0")
+	}
+	if v := find.Cursor; v != nil {
+		where, args = append(where, fmt.Sprintf("(updated_ts < %s OR (updated_ts = %s AND id < %s))", placeholder(len(args)+1), placeholder(len(args)+1), placeholder(len(args)+2))), append(args, v.UpdatedTs, v.ID)
+	}
 
-	fields := []string{"id", "uid", "filename", "type", "size", "creator_id", "created_ts", "updated_ts", "memo_id", "storage_type", "reference", "payload"}
+	fields := []string{"id", "uid", "filename", "type", "size", "creator_id", "created_ts", "updated_ts", "deleted_ts", "memo_id", "storage_type", "reference", "payload"}
 	if find.GetBlob {
 		fields = append(fields, "blob")
 	}
@@ -71,7 +87,7 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 			%s
 		FROM resource
 		WHERE %s
-		ORDER BY updated_ts DESC
+		ORDER BY updated_ts DESC, id DESC
 	`, strings.Join(fields, ", "), strings.Join(where, " AND "))
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
@@ -101,6 +117,7 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 			&resource.CreatorID,
 			&resource.CreatedTs,
 			&resource.UpdatedTs,
+			&resource.DeletedTs,
 			&memoID,
 			&storageType,
 			&resource.Reference,
@@ -141,6 +158,9 @@ func (d *DB) UpdateResource(ctx context.Context, update *store.UpdateResource) e
 	if v := update.UpdatedTs; v != nil {
 		set, args = append(set, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := update.DeletedTs; v != nil {
+		set, args = append(set, "deleted_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
 	if v := update.Filename; v != nil {
 		set, args = append(set, "filename = "+placeholder(len(args)+1)), append(args, *v)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -40,13 +41,30 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 
 func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
 	where, args := []string{"1 = 1"}, []any{}
+	joinFullText := false
 
 	if v := find.ID; v != nil {
 		where, args = append(where, "`memo`.`id` = ?"), append(args, *v)
 	}
+	if v := find.IDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders = append(holders, "?")
+			args = append(args, id)
+		}
+		where = append(where, "`memo`.`id` IN ("+strings.Join(holders, ", ")+")")
+	}
 	if v := find.UID; v != nil {
 		where, args = append(where, "`memo`.`uid` = ?"), append(args, *v)
 	}
+	if v := find.UIDList; len(v) != 0 {
+		holders := []string{}
+		for _, uid := range v {
+			holders = append(holders, "?")
+			args = append(args, uid)
+		}
+		where = append(where, "`memo`.`uid` IN ("+strings.Join(holders, ", ")+")")
+	}
 	if v := find.CreatorID; v != nil {
 		where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
 	}
@@ -65,11 +83,34 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if v := find.UpdatedTsAfter; v != nil {
 		where, args = append(where, "`memo`.`updated_ts` > ?"), append(args, *v)
 	}
+	if find.Trashed {
+		where = append(where, "`memo`.`deleted_ts` > 0")
+	} else if !find.IncludeTrashed {
+		where = append(where, "`memo`.`deleted_ts` = 0")
+	}
+	if v := find.DeletedTsBefore; v != nil {
+		where, args = append(where, "`memo`.`deleted_ts` < ?"), append(args, *v)
+	}
 	if v := find.ContentSearch; len(v) != 0 {
 		for _, s := range v {
 			where, args = append(where, "`memo`.`content` LIKE ?"), append(args, fmt.Sprintf("%%%s%%", s))
 		}
 	}
+	if v := find.ContentQuery; v != nil {
+		matchTerms := []string{}
+		for _, term := range strings.Fields(*v) {
+			// The trigram tokenizer can not match terms shorter than three characters.
+			if utf8.RuneCountInString(term) < 3 {
+				where, args = append(where, "`memo`.`content` LIKE ?"), append(args, fmt.Sprintf("%%%s%%", term))
+			} else {
+				matchTerms = append(matchTerms, `"`+strings.ReplaceAll(term, `"`, `""`)+`"`)
+			}
+		}
+		if len(matchTerms) > 0 {
+			joinFullText = true
+			where, args = append(where, "`memo_fts` MATCH ?"), append(args, strings.Join(matchTerms, " "))
+		}
+	}
 	if v := find.VisibilityList; len(v) != 0 {
 		placeholder := []string{}
 		for _, visibility := range v {
@@ -98,11 +139,41 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			where = append(where, "JSON_EXTRACT(`memo`.`payload`, '$.property.hasIncompleteTasks') IS TRUE")
 		}
 	}
+	if v := find.Filter; v != nil {
+		condition, newArgs, err := renderMemoFilter(v, args)
+		if err != nil {
+			return nil, err
+		}
+		where, args = append(where, condition), newArgs
+	}
+	if v := find.ParentID; v != nil {
+		where, args = append(where, "`memo_relation`.`related_memo_id` = ?"), append(args, *v)
+	}
 	if find.ExcludeComments {
 		where = append(where, "`parent_id` IS NULL")
 	}
+	if v := find.Cursor; v != nil {
+		displayTsColumn := "`memo`.`created_ts`"
+		if find.OrderByUpdatedTs {
+			displayTsColumn = "`memo`.`updated_ts`"
+		}
+		condition := fmt.Sprintf("(%s < ? OR (%s = ? AND `memo`.`id` < ?))", displayTsColumn, displayTsColumn)
+		cursorArgs := []any{v.DisplayTs, v.DisplayTs, v.ID}
+		if find.OrderByPinned {
+			pinned := 0
+			if v.Pinned {
+				pinned = 1
+			}
+			condition = fmt.Sprintf("(IFNULL(`memo_organizer`.`pinned`, 0) < ? OR (IFNULL(`memo_organizer`.`pinned`, 0) = ? AND %s))", condition)
+			cursorArgs = append([]any{pinned, pinned}, cursorArgs...)
+		}
+		where, args = append(where, condition), append(args, cursorArgs...)
+	}
 
 	orderBy := []string{}
+	if find.OrderByRelevance && joinFullText {
+		orderBy = append(orderBy, "bm25(`memo_fts`)")
+	}
 	if find.OrderByPinned {
 		orderBy = append(orderBy, "`pinned` DESC")
 	}
@@ -122,6 +193,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		"`memo`.`creator_id` AS `creator_id`",
 		"`memo`.`created_ts` AS `created_ts`",
 		"`memo`.`updated_ts` AS `updated_ts`",
+		"`memo`.`deleted_ts` AS `deleted_ts`",
 		"`memo`.`row_status` AS `row_status`",
 		"`memo`.`visibility` AS `visibility`",
 		"`memo`.`payload` AS `payload`",
@@ -132,8 +204,11 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		fields = append(fields, "`memo`.`content` AS `content`")
 	}
 
-	query := "SELECT " + strings.Join(fields, ", ") + "FROM `memo` " +
-		"LEFT JOIN `memo_organizer` ON `memo`.`id` = `memo_organizer`.`memo_id` AND `memo`.`creator_id` = `memo_organizer`.`user_id` " +
+	query := "SELECT " + strings.Join(fields, ", ") + "FROM `memo` "
+	if joinFullText {
+		query += "JOIN `memo_fts` ON `memo`.`id` = `memo_fts`.`rowid` "
+	}
+	query += "LEFT JOIN `memo_organizer` ON `memo`.`id` = `memo_organizer`.`memo_id` AND `memo`.`creator_id` = `memo_organizer`.`user_id` " +
 		"LEFT JOIN `memo_relation` ON `memo`.`id` = `memo_relation`.`memo_id` AND `memo_relation`.`type` = \"COMMENT\" " +
 		"WHERE " + strings.Join(where, " AND ") + " " +
 		"ORDER BY " + strings.Join(orderBy, ", ")
@@ -160,6 +235,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.CreatorID,
 			&memo.CreatedTs,
 			&memo.UpdatedTs,
+			&memo.DeletedTs,
 			&memo.RowStatus,
 			&memo.Visibility,
 			&payloadBytes,
@@ -198,6 +274,9 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if v := update.UpdatedTs; v != nil {
 		set, args = append(set, "`updated_ts` = ?"), append(args, *v)
 	}
+	if v := update.DeletedTs; v != nil {
+		set, args = append(set, "`deleted_ts` = ?"), append(args, *v)
+	}
 	if v := update.RowStatus; v != nil {
 		set, args = append(set, "`row_status` = ?"), append(args, *v)
 	}
@@ -214,12 +293,26 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 		}
 		set, args = append(set, "`payload` = ?"), append(args, string(payloadBytes))
 	}
+	where := []string{"`id` = ?"}
 	args = append(args, update.ID)
+	if v := update.ExpectedUpdatedTs; v != nil {
+		where, args = append(where, "`updated_ts` = ?"), append(args, *v)
+	}
 
-	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
-	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE " + strings.Join(where, " AND ")
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
 		return err
 	}
+	if update.ExpectedUpdatedTs != nil {
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return store.ErrMemoChanged
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,12 @@
+CREATE TABLE memo_task (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  position INTEGER NOT NULL,
+  content TEXT NOT NULL DEFAULT '',
+  completed INTEGER NOT NULL CHECK (completed IN (0, 1)) DEFAULT 0,
+  due_ts BIGINT,
+  UNIQUE(memo_id, position)
+);
+
+CREATE INDEX idx_memo_task_creator_id ON memo_task (creator_id);
@@ -0,0 +1,10 @@
+CREATE TABLE memo_reminder (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  task_position INTEGER,
+  remind_ts BIGINT NOT NULL,
+  fired_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_memo_reminder_remind_ts ON memo_reminder (fired_ts, remind_ts);
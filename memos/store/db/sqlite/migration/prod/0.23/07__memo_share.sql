@@ -0,0 +1,11 @@
+CREATE TABLE memo_share (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  memo_id INTEGER NOT NULL,
+  creator_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  permission TEXT NOT NULL CHECK (permission IN ('READ', 'COMMENT', 'EDIT')) DEFAULT 'READ',
+  UNIQUE(memo_id, user_id)
+);
+
+CREATE INDEX idx_memo_share_user_id ON memo_share (user_id);
@@ -0,0 +1,13 @@
+CREATE TABLE recurring_memo (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  state TEXT NOT NULL CHECK (state IN ('ACTIVE', 'PAUSED')) DEFAULT 'ACTIVE',
+  cron_expression TEXT NOT NULL,
+  timezone TEXT NOT NULL DEFAULT 'UTC',
+  last_run_ts BIGINT NOT NULL DEFAULT 0,
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_recurring_memo_creator_id ON recurring_memo (creator_id);
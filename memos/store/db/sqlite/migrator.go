@@ -196,7 +196,7 @@ func (d *DB) seed(ctx context.Context) error {
 
 // execute runs a single SQL statement within a transaction.
 func (d *DB) execute(ctx context.Context, stmt string) error {
-	tx, err := d.db.Begin()
+	tx, err := d.sqlDB.Begin()
 	if err != nil {
 		return err
 	}
@@ -56,19 +56,35 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 	if v := find.MemoID; v != nil {
 		where, args = append(where, "`memo_id` = ?"), append(args, *v)
 	}
+	if v := find.MemoIDList; len(v) != 0 {
+		holders := []string{}
+		for _, memoID := range v {
+			holders = append(holders, "?")
+			args = append(args, memoID)
+		}
+		where = append(where, "`memo_id` IN ("+strings.Join(holders, ", ")+")")
+	}
 	if find.HasRelatedMemo {
 		where = append(where, "`memo_id` IS NOT NULL")
 	}
 	if find.StorageType != nil {
 		where, args = append(where, "`storage_type` = ?"), append(args, find.StorageType.String())
 	}
+	if find.Trashed {
+		where = append(where, "`deleted_ts` > 0")
+	} else if !find.IncludeTrashed {
+		where = append(where, "`deleted_ts` = 0")
+	}
+	if v := find.Cursor; v != nil {
+		where, args = append(where, "(`updated_ts` < ? OR (`updated_ts` = ? AND `id` < ?))"), append(args, v.UpdatedTs, v.UpdatedTs, v.ID)
+	}
 
-	fields := []string{"`id`", "`uid`", "`filename`", "`type`", "`size`", "`creator_id`", "`created_ts`", "`updated_ts`", "`memo_id`", "`storage_type`", "`reference`", "`payload`"}
+	fields := []string{"`id`", "`uid`", "`filename`", "`type`", "`size`", "`creator_id`", "`created_ts`", "`updated_ts`", "`deleted_ts`", "`memo_id`", "`storage_type`", "`reference`", "`payload`"}
 	if find.GetBlob {
 		fields = append(fields, "`blob`")
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM `resource` WHERE %s ORDER BY `updated_ts` DESC", strings.Join(fields, ", "), strings.Join(where, " AND "))
+	query := fmt.Sprintf("SELECT %s FROM `resource` WHERE %s ORDER BY `updated_ts` DESC, `id` DESC", strings.Join(fields, ", "), strings.Join(where, " AND "))
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
 		if find.Offset != nil {
@@ -97,6 +113,7 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 			&resource.CreatorID,
 			&resource.CreatedTs,
 			&resource.UpdatedTs,
+			&resource.DeletedTs,
 			&memoID,
 			&storageType,
 			&resource.Reference,
@@ -137,6 +154,9 @@ func (d *DB) UpdateResource(ctx context.Context, update *store.UpdateResource) e
 	if v := update.UpdatedTs; v != nil {
 		set, args = append(set, "`updated_ts` = ?"), append(args, *v)
 	}
+	if v := update.DeletedTs; v != nil {
+		set, args = append(set, "`deleted_ts` = ?"), append(args, *v)
+	}
 	if v := update.Filename; v != nil {
 		set, args = append(set, "`filename` = ?"), append(args, *v)
 	}
@@ -16,8 +16,17 @@ import (
 	"github.com/usememos/memos/store"
 )
 
+// executor runs statements on either a database or a transaction.
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
 type DB struct {
-	db      *sql.DB
+	sqlDB *sql.DB
+	// db is the database, or the transaction when the driver is created by WithTx.
+	db      executor
 	profile *profile.Profile
 }
 
@@ -36,6 +45,8 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 	// good practice to be explicit and prevent future surprises on SQLite upgrades.
 	// - Journal mode set to WAL: it's the recommended journal mode for most applications
 	// as it prevents locking issues.
+	// - Transactions take the write lock when they begin: a deferred transaction that reads
+	// before writing fails instead of waiting for the busy timeout when another one wrote meanwhile.
 	//
 	// Notes:
 	// - When using the `modernc.org/sqlite` driver, each pragma must be prefixed with `_pragma=`.
@@ -44,18 +55,18 @@ func NewDB(profile *profile.Profile) (store.Driver, error) {
 	// - https://pkg.go.dev/modernc.org/sqlite#Driver.Open
 	// - https://www.sqlite.org/sharedcache.html
 	// - https://www.sqlite.org/pragma.html
-	sqliteDB, err := sql.Open("sqlite", profile.DSN+"?_pragma=foreign_keys(0)&_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)")
+	sqliteDB, err := sql.Open("sqlite", profile.DSN+"?_pragma=foreign_keys(0)&_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)&_txlock=immediate")
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to open db with dsn: %s", profile.DSN)
 	}
 
-	driver := DB{db: sqliteDB, profile: profile}
+	driver := DB{sqlDB: sqliteDB, db: sqliteDB, profile: profile}
 
 	return &driver, nil
 }
 
 func (d *DB) GetDB() *sql.DB {
-	return d.db
+	return d.sqlDB
 }
 
 func (d *DB) GetCurrentDBSize(context.Context) (int64, error) {
@@ -68,5 +79,27 @@ func (d *DB) GetCurrentDBSize(context.Context) (int64, error) {
 }
 
 func (d *DB) Close() error {
-	return d.db.Close()
+	return d.sqlDB.Close()
+}
+
+// WithTx runs fn with a driver whose statements are executed within a transaction.
+// The transaction is committed if fn returns nil and rolled back otherwise.
+func (d *DB) WithTx(ctx context.Context, fn func(driver store.Driver) error) error {
+	// Join the current transaction if there is one.
+	if _, ok := d.db.(*sql.Tx); ok {
+		return fn(d)
+	}
+
+	tx, err := d.sqlDB.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer tx.Rollback()
+
+	txDriver := *d
+	txDriver.db = tx
+	if err := fn(&txDriver); err != nil {
+		return err
+	}
+	return tx.Commit()
 }
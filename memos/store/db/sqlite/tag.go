@@ -0,0 +1,149 @@
+package sqlite
+
+import (
+	"context"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateTag(ctx context.Context, create *store.Tag) (*store.Tag, error) {
+	pinned := 0
+	if create.Pinned {
+		pinned = 1
+	}
+	fields := []string{"`creator_id`", "`name`", "`color`", "`emoji`", "`description`", "`pinned`", "`sort_order`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?", "?"}
+	args := []any{create.CreatorID, create.Name, create.Color, create.Emoji, create.Description, pinned, create.SortOrder}
+	stmt := "INSERT INTO `tag` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+	return create, nil
+}
+
+func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.Name; v != nil {
+		where, args = append(where, "`name` = ?"), append(args, *v)
+	}
+	if v := find.NameList; len(v) != 0 {
+		holders := []string{}
+		for _, name := range v {
+			holders = append(holders, "?")
+			args = append(args, name)
+		}
+		where = append(where, "`name` IN ("+strings.Join(holders, ", ")+")")
+	}
+
+	rows, err := d.db.QueryContext(ctx, `
+		SELECT
+			id,
+			creator_id,
+			created_ts,
+			updated_ts,
+			name,
+			color,
+			emoji,
+			description,
+			pinned,
+			sort_order
+		FROM tag
+		WHERE `+strings.Join(where, " AND ")+`
+		ORDER BY pinned DESC, sort_order ASC, name ASC`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Tag{}
+	for rows.Next() {
+		tag := &store.Tag{}
+		if err := rows.Scan(
+			&tag.ID,
+			&tag.CreatorID,
+			&tag.CreatedTs,
+			&tag.UpdatedTs,
+			&tag.Name,
+			&tag.Color,
+			&tag.Emoji,
+			&tag.Description,
+			&tag.Pinned,
+			&tag.SortOrder,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateTag(ctx context.Context, update *store.UpdateTag) (*store.Tag, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = ?"), append(args, *v)
+	}
+	if v := update.Name; v != nil {
+		set, args = append(set, "`name` = ?"), append(args, *v)
+	}
+	if v := update.Color; v != nil {
+		set, args = append(set, "`color` = ?"), append(args, *v)
+	}
+	if v := update.Emoji; v != nil {
+		set, args = append(set, "`emoji` = ?"), append(args, *v)
+	}
+	if v := update.Description; v != nil {
+		set, args = append(set, "`description` = ?"), append(args, *v)
+	}
+	if v := update.Pinned; v != nil {
+		pinned := 0
+		if *v {
+			pinned = 1
+		}
+		set, args = append(set, "`pinned` = ?"), append(args, pinned)
+	}
+	if v := update.SortOrder; v != nil {
+		set, args = append(set, "`sort_order` = ?"), append(args, *v)
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE `tag` SET " + strings.Join(set, ", ") + " WHERE `id` = ? RETURNING `id`, `creator_id`, `created_ts`, `updated_ts`, `name`, `color`, `emoji`, `description`, `pinned`, `sort_order`"
+	tag := &store.Tag{}
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&tag.ID,
+		&tag.CreatorID,
+		&tag.CreatedTs,
+		&tag.UpdatedTs,
+		&tag.Name,
+		&tag.Color,
+		&tag.Emoji,
+		&tag.Description,
+		&tag.Pinned,
+		&tag.SortOrder,
+	); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (d *DB) DeleteTag(ctx context.Context, delete *store.DeleteTag) error {
+	_, err := d.db.ExecContext(ctx, "DELETE FROM `tag` WHERE `id` = ?", delete.ID)
+	return err
+}
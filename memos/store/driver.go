@@ -10,6 +10,9 @@ import (
 type Driver interface {
 	GetDB() *sql.DB
 	Close() error
+	// WithTx runs fn with a driver bound to a transaction, which is committed if fn returns nil
+	// and rolled back otherwise.
+	WithTx(ctx context.Context, fn func(driver Driver) error) error
 
 	Migrate(ctx context.Context) error
 
@@ -40,6 +43,12 @@ type Driver interface {
 	UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error)
 	ListMemoRelations(ctx context.Context, find *FindMemoRelation) ([]*MemoRelation, error)
 	DeleteMemoRelation(ctx context.Context, delete *DeleteMemoRelation) error
+	ListMemoGraphNodes(ctx context.Context, find *FindMemoGraph) ([]*MemoGraphNode, error)
+
+	// MemoRevision model related methods.
+	CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error)
+	ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error)
+	DeleteMemoRevision(ctx context.Context, delete *DeleteMemoRevision) error
 
 	// MemoOrganizer model related methods.
 	UpsertMemoOrganizer(ctx context.Context, upsert *MemoOrganizer) (*MemoOrganizer, error)
@@ -83,4 +92,54 @@ type Driver interface {
 	UpsertReaction(ctx context.Context, create *Reaction) (*Reaction, error)
 	ListReactions(ctx context.Context, find *FindReaction) ([]*Reaction, error)
 	DeleteReaction(ctx context.Context, delete *DeleteReaction) error
+
+	// MemoTask model related methods.
+	CreateMemoTask(ctx context.Context, create *MemoTask) (*MemoTask, error)
+	ListMemoTasks(ctx context.Context, find *FindMemoTask) ([]*MemoTask, error)
+	DeleteMemoTask(ctx context.Context, delete *DeleteMemoTask) error
+
+	// MemoReminder model related methods.
+	CreateMemoReminder(ctx context.Context, create *MemoReminder) (*MemoReminder, error)
+	ListMemoReminders(ctx context.Context, find *FindMemoReminder) ([]*MemoReminder, error)
+	UpdateMemoReminder(ctx context.Context, update *UpdateMemoReminder) (*MemoReminder, error)
+	DeleteMemoReminder(ctx context.Context, delete *DeleteMemoReminder) error
+
+	// MemoShareLink model related methods.
+	CreateMemoShareLink(ctx context.Context, create *MemoShareLink) (*MemoShareLink, error)
+	ListMemoShareLinks(ctx context.Context, find *FindMemoShareLink) ([]*MemoShareLink, error)
+	DeleteMemoShareLink(ctx context.Context, delete *DeleteMemoShareLink) error
+
+	// MemoShare model related methods.
+	UpsertMemoShare(ctx context.Context, upsert *MemoShare) (*MemoShare, error)
+	ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error)
+	DeleteMemoShare(ctx context.Context, delete *DeleteMemoShare) error
+
+	// MemoTemplate model related methods.
+	CreateMemoTemplate(ctx context.Context, create *MemoTemplate) (*MemoTemplate, error)
+	ListMemoTemplates(ctx context.Context, find *FindMemoTemplate) ([]*MemoTemplate, error)
+	UpdateMemoTemplate(ctx context.Context, update *UpdateMemoTemplate) (*MemoTemplate, error)
+	DeleteMemoTemplate(ctx context.Context, delete *DeleteMemoTemplate) error
+
+	// RecurringMemo model related methods.
+	CreateRecurringMemo(ctx context.Context, create *RecurringMemo) (*RecurringMemo, error)
+	ListRecurringMemos(ctx context.Context, find *FindRecurringMemo) ([]*RecurringMemo, error)
+	UpdateRecurringMemo(ctx context.Context, update *UpdateRecurringMemo) (*RecurringMemo, error)
+	DeleteRecurringMemo(ctx context.Context, delete *DeleteRecurringMemo) error
+
+	// Collection model related methods.
+	CreateCollection(ctx context.Context, create *Collection) (*Collection, error)
+	ListCollections(ctx context.Context, find *FindCollection) ([]*Collection, error)
+	UpdateCollection(ctx context.Context, update *UpdateCollection) (*Collection, error)
+	DeleteCollection(ctx context.Context, delete *DeleteCollection) error
+
+	// CollectionMemo model related methods.
+	UpsertCollectionMemo(ctx context.Context, upsert *CollectionMemo) (*CollectionMemo, error)
+	ListCollectionMemos(ctx context.Context, find *FindCollectionMemo) ([]*CollectionMemo, error)
+	DeleteCollectionMemo(ctx context.Context, delete *DeleteCollectionMemo) error
+
+	// Tag model related methods.
+	CreateTag(ctx context.Context, create *Tag) (*Tag, error)
+	ListTags(ctx context.Context, find *FindTag) ([]*Tag, error)
+	UpdateTag(ctx context.Context, update *UpdateTag) (*Tag, error)
+	DeleteTag(ctx context.Context, delete *DeleteTag) error
 }
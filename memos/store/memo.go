@@ -44,6 +44,8 @@ type Memo struct {
 	CreatorID int32
 	CreatedTs int64
 	UpdatedTs int64
+	// DeletedTs is the time when the memo was moved into the trash bin, 0 if it is not in the trash bin.
+	DeletedTs int64
 
 	// Domain specific fields
 	Content    string
@@ -55,9 +57,21 @@ type Memo struct {
 	ParentID *int32
 }
 
+// MemoCursor is the position of a memo in the list order, used for keyset pagination.
+type MemoCursor struct {
+	Pinned bool
+	// DisplayTs is the updated_ts when ordering by updated time, otherwise the created_ts.
+	DisplayTs int64
+	ID        int32
+}
+
 type FindMemo struct {
 	ID  *int32
 	UID *string
+	// IDList finds the memos with any of the ids.
+	IDList []int32
+	// UIDList finds the memos with any of the uids.
+	UIDList []string
 
 	// Standard fields
 	RowStatus       *RowStatus
@@ -67,19 +81,37 @@ type FindMemo struct {
 	UpdatedTsAfter  *int64
 	UpdatedTsBefore *int64
 
+	// Trash bin
+	// Memos in the trash bin are excluded unless Trashed or IncludeTrashed is set.
+	// Trashed finds only the memos in the trash bin.
+	Trashed         bool
+	IncludeTrashed  bool
+	DeletedTsBefore *int64
+
 	// Domain specific fields
-	ContentSearch   []string
-	VisibilityList  []Visibility
-	PayloadFind     *FindMemoPayload
+	ContentSearch []string
+	// ContentQuery is a full-text query matched against the content index.
+	ContentQuery   *string
+	VisibilityList []Visibility
+	PayloadFind    *FindMemoPayload
+	// Filter is an additional filter built from the user's filter expression.
+	Filter MemoFilter
+	// ParentID finds the comments of the memo.
+	ParentID        *int32
 	ExcludeContent  bool
 	ExcludeComments bool
 	Random          bool
 
 	// Pagination
-	Limit            *int
-	Offset           *int
+	Limit  *int
+	Offset *int
+	// Cursor finds the memos that come after it in the list order.
+	// It is not applicable to random and relevance orders.
+	Cursor           *MemoCursor
 	OrderByUpdatedTs bool
 	OrderByPinned    bool
+	// OrderByRelevance orders memos by the relevance to ContentQuery.
+	OrderByRelevance bool
 }
 
 type FindMemoPayload struct {
@@ -96,12 +128,19 @@ type UpdateMemo struct {
 	UID        *string
 	CreatedTs  *int64
 	UpdatedTs  *int64
+	DeletedTs  *int64
 	RowStatus  *RowStatus
 	Content    *string
 	Visibility *Visibility
 	Payload    *storepb.MemoPayload
+	// ExpectedUpdatedTs makes the update only apply if the memo has not been updated since.
+	// ErrMemoChanged is returned otherwise.
+	ExpectedUpdatedTs *int64
 }
 
+// ErrMemoChanged is returned by conditional updates of memos that have been changed meanwhile.
+var ErrMemoChanged = errors.New("memo has been changed")
+
 type DeleteMemo struct {
 	ID int32
 }
@@ -134,6 +173,9 @@ func (s *Store) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
 	if update.UID != nil && !util.UIDMatcher.MatchString(*update.UID) {
 		return errors.New("invalid uid")
 	}
+	if err := s.snapshotMemo(ctx, update); err != nil {
+		return err
+	}
 	return s.driver.UpdateMemo(ctx, update)
 }
 
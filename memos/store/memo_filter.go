@@ -0,0 +1,87 @@
+package store
+
+// MemoFilter is a driver-neutral boolean expression used to filter memos.
+// Each store driver renders it to its own SQL dialect.
+type MemoFilter interface {
+	isMemoFilter()
+}
+
+// MemoFilterField is the field of a memo that a condition applies to.
+type MemoFilterField string
+
+const (
+	MemoFilterFieldContent            MemoFilterField = "content"
+	MemoFilterFieldTag                MemoFilterField = "tag"
+	MemoFilterFieldVisibility         MemoFilterField = "visibility"
+	MemoFilterFieldCreatorID          MemoFilterField = "creator_id"
+	MemoFilterFieldUID                MemoFilterField = "uid"
+	MemoFilterFieldRowStatus          MemoFilterField = "row_status"
+	MemoFilterFieldCreatedTs          MemoFilterField = "created_ts"
+	MemoFilterFieldUpdatedTs          MemoFilterField = "updated_ts"
+	MemoFilterFieldHasLink            MemoFilterField = "has_link"
+	MemoFilterFieldHasTaskList        MemoFilterField = "has_task_list"
+	MemoFilterFieldHasCode            MemoFilterField = "has_code"
+	MemoFilterFieldHasIncompleteTasks MemoFilterField = "has_incomplete_tasks"
+	// MemoFilterFieldPublishTs is the time the scheduled visibility is applied at, 0 if the memo is not scheduled.
+	MemoFilterFieldPublishTs MemoFilterField = "publish_ts"
+	// MemoFilterFieldExpireTs is the time the memo expires at, 0 if the memo never expires.
+	MemoFilterFieldExpireTs MemoFilterField = "expire_ts"
+	// MemoFilterFieldSharedWith is the ID of a user the memo is shared with. It only supports MemoFilterOperatorEqual.
+	MemoFilterFieldSharedWith MemoFilterField = "shared_with"
+	// MemoFilterFieldCollectionID is the ID of a collection the memo is in. It only supports MemoFilterOperatorEqual.
+	MemoFilterFieldCollectionID MemoFilterField = "collection_id"
+	// MemoFilterFieldCustomProperty is the custom property named by the key of the condition.
+	// The value is a string, a float64 or a bool, which is compared with the value of the same type only.
+	MemoFilterFieldCustomProperty MemoFilterField = "custom_property"
+)
+
+// MemoFilterOperator is the operator of a condition.
+type MemoFilterOperator string
+
+const (
+	MemoFilterOperatorEqual          MemoFilterOperator = "="
+	MemoFilterOperatorNotEqual       MemoFilterOperator = "!="
+	MemoFilterOperatorLess           MemoFilterOperator = "<"
+	MemoFilterOperatorLessOrEqual    MemoFilterOperator = "<="
+	MemoFilterOperatorGreater        MemoFilterOperator = ">"
+	MemoFilterOperatorGreaterOrEqual MemoFilterOperator = ">="
+	// MemoFilterOperatorIn matches any of the values. The value must be a []any.
+	MemoFilterOperatorIn MemoFilterOperator = "IN"
+	// MemoFilterOperatorStartsWith and MemoFilterOperatorContains match string prefix and substring.
+	// For tags, they match if any of the tags matches.
+	MemoFilterOperatorStartsWith MemoFilterOperator = "STARTS_WITH"
+	MemoFilterOperatorContains   MemoFilterOperator = "CONTAINS"
+	// MemoFilterOperatorSubtree only applies to tags. It matches if any of the tags is the value
+	// or one of its descendants, e.g. `project/alpha` is in the subtree of `project`.
+	MemoFilterOperatorSubtree MemoFilterOperator = "SUBTREE"
+)
+
+// MemoFilterAnd matches if all of the filters match.
+type MemoFilterAnd struct {
+	Filters []MemoFilter
+}
+
+// MemoFilterOr matches if any of the filters matches.
+type MemoFilterOr struct {
+	Filters []MemoFilter
+}
+
+// MemoFilterNot matches if the filter does not match.
+type MemoFilterNot struct {
+	Filter MemoFilter
+}
+
+// MemoFilterCondition compares a memo field with a value.
+// The value is a string, an int64 or a bool depending on the field.
+type MemoFilterCondition struct {
+	Field    MemoFilterField
+	Operator MemoFilterOperator
+	Value    any
+	// Key is the name of the custom property for MemoFilterFieldCustomProperty.
+	Key string
+}
+
+func (*MemoFilterAnd) isMemoFilter()       {}
+func (*MemoFilterOr) isMemoFilter()        {}
+func (*MemoFilterNot) isMemoFilter()       {}
+func (*MemoFilterCondition) isMemoFilter() {}
@@ -23,6 +23,10 @@ type FindMemoRelation struct {
 	MemoID        *int32
 	RelatedMemoID *int32
 	Type          *MemoRelationType
+
+	// MemoIDList and RelatedMemoIDList batch load the relations of many memos.
+	MemoIDList        []int32
+	RelatedMemoIDList []int32
 }
 
 type DeleteMemoRelation struct {
@@ -31,6 +35,23 @@ type DeleteMemoRelation struct {
 	Type          *MemoRelationType
 }
 
+// FindMemoGraph finds the memos that are reachable from the root memos through relations in either direction.
+type FindMemoGraph struct {
+	RootMemoIDList []int32
+	// MaxDepth is the maximum number of relations between a root memo and a reachable memo.
+	MaxDepth int
+	// TypeList is the types of the relations to traverse. All relations are traversed if it is empty.
+	TypeList []MemoRelationType
+	// Filter limits the memos to traverse, along with the root memos. Only normal memos are traversed.
+	Filter MemoFilter
+}
+
+type MemoGraphNode struct {
+	MemoID int32
+	// Depth is the least number of relations between a root memo and the memo.
+	Depth int
+}
+
 func (s *Store) UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error) {
 	return s.driver.UpsertMemoRelation(ctx, create)
 }
@@ -42,3 +63,9 @@ func (s *Store) ListMemoRelations(ctx context.Context, find *FindMemoRelation) (
 func (s *Store) DeleteMemoRelation(ctx context.Context, delete *DeleteMemoRelation) error {
 	return s.driver.DeleteMemoRelation(ctx, delete)
 }
+
+// ListMemoGraphNodes lists the memos reachable from the root memos in a single recursive query.
+// The nodes are ordered by depth and memo id.
+func (s *Store) ListMemoGraphNodes(ctx context.Context, find *FindMemoGraph) ([]*MemoGraphNode, error) {
+	return s.driver.ListMemoGraphNodes(ctx, find)
+}
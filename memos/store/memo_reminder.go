@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoReminder is a reminder of a memo, or of one of its tasks, which is delivered to the inbox of the creator.
+type MemoReminder struct {
+	ID        int32
+	MemoID    int32
+	CreatorID int32
+	// TaskPosition is the position of the task that the reminder is set on, if any.
+	TaskPosition *int32
+	RemindTs     int64
+	// FiredTs is the time the reminder fired, or 0 if it is pending.
+	FiredTs int64
+}
+
+type FindMemoReminder struct {
+	ID        *int32
+	MemoID    *int32
+	CreatorID *int32
+	// TaskPosition finds the reminders of the task, and HasTask the reminders with or without a task.
+	TaskPosition *int32
+	HasTask      *bool
+	// Pending finds the reminders that have not fired yet, or those that have.
+	Pending        *bool
+	RemindTsBefore *int64
+}
+
+type UpdateMemoReminder struct {
+	ID           int32
+	TaskPosition *int32
+	RemindTs     *int64
+	FiredTs      *int64
+}
+
+type DeleteMemoReminder struct {
+	ID     *int32
+	MemoID *int32
+}
+
+func (s *Store) CreateMemoReminder(ctx context.Context, create *MemoReminder) (*MemoReminder, error) {
+	return s.driver.CreateMemoReminder(ctx, create)
+}
+
+func (s *Store) ListMemoReminders(ctx context.Context, find *FindMemoReminder) ([]*MemoReminder, error) {
+	return s.driver.ListMemoReminders(ctx, find)
+}
+
+func (s *Store) GetMemoReminder(ctx context.Context, find *FindMemoReminder) (*MemoReminder, error) {
+	list, err := s.ListMemoReminders(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateMemoReminder(ctx context.Context, update *UpdateMemoReminder) (*MemoReminder, error) {
+	return s.driver.UpdateMemoReminder(ctx, update)
+}
+
+func (s *Store) DeleteMemoReminder(ctx context.Context, delete *DeleteMemoReminder) error {
+	return s.driver.DeleteMemoReminder(ctx, delete)
+}
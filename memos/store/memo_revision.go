@@ -0,0 +1,118 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// MemoRevision is a snapshot of a memo taken before it was updated.
+type MemoRevision struct {
+	ID        int32
+	MemoID    int32
+	CreatorID int32
+	// CreatedTs is the time when the snapshotted version of the memo was written.
+	CreatedTs int64
+
+	Content    string
+	Visibility Visibility
+	Payload    *storepb.MemoPayload
+}
+
+type FindMemoRevision struct {
+	ID     *int32
+	MemoID *int32
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+type DeleteMemoRevision struct {
+	ID     *int32
+	MemoID *int32
+}
+
+func (s *Store) CreateMemoRevision(ctx context.Context, create *MemoRevision) (*MemoRevision, error) {
+	return s.driver.CreateMemoRevision(ctx, create)
+}
+
+// ListMemoRevisions lists memo revisions ordered from the newest to the oldest.
+func (s *Store) ListMemoRevisions(ctx context.Context, find *FindMemoRevision) ([]*MemoRevision, error) {
+	return s.driver.ListMemoRevisions(ctx, find)
+}
+
+func (s *Store) GetMemoRevision(ctx context.Context, find *FindMemoRevision) (*MemoRevision, error) {
+	list, err := s.ListMemoRevisions(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	memoRevision := list[0]
+	return memoRevision, nil
+}
+
+func (s *Store) DeleteMemoRevision(ctx context.Context, delete *DeleteMemoRevision) error {
+	return s.driver.DeleteMemoRevision(ctx, delete)
+}
+
+// snapshotMemo saves the current content, visibility and payload of the memo as a revision
+// if the given update is going to change any of them.
+func (s *Store) snapshotMemo(ctx context.Context, update *UpdateMemo) error {
+	if update.Content == nil && update.Visibility == nil && update.Payload == nil {
+		return nil
+	}
+
+	memo, err := s.GetMemo(ctx, &FindMemo{ID: &update.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	if memo == nil {
+		return nil
+	}
+	changed := (update.Content != nil && *update.Content != memo.Content) ||
+		(update.Visibility != nil && *update.Visibility != memo.Visibility) ||
+		(update.Payload != nil && !proto.Equal(update.Payload, memo.Payload))
+	if !changed {
+		return nil
+	}
+	workspaceMemoRelatedSetting, err := s.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace memo related setting")
+	}
+	// The revisions are disabled with a limit of 0.
+	limit := int(workspaceMemoRelatedSetting.GetMemoRevisionLimit())
+	if limit == 0 {
+		return nil
+	}
+
+	if _, err := s.CreateMemoRevision(ctx, &MemoRevision{
+		MemoID:     memo.ID,
+		CreatorID:  memo.CreatorID,
+		CreatedTs:  memo.UpdatedTs,
+		Content:    memo.Content,
+		Visibility: memo.Visibility,
+		Payload:    memo.Payload,
+	}); err != nil {
+		return errors.Wrap(err, "failed to create memo revision")
+	}
+
+	revisions, err := s.ListMemoRevisions(ctx, &FindMemoRevision{MemoID: &memo.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo revisions")
+	}
+	if len(revisions) > limit {
+		for _, revision := range revisions[limit:] {
+			if err := s.DeleteMemoRevision(ctx, &DeleteMemoRevision{ID: &revision.ID}); err != nil {
+				return errors.Wrap(err, "failed to delete outdated memo revision")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoSharePermission is the access that a memo share grants to the user it is shared with.
+type MemoSharePermission string
+
+const (
+	// MemoSharePermissionRead grants read access to the memo.
+	MemoSharePermissionRead MemoSharePermission = "READ"
+	// MemoSharePermissionComment grants read access to the memo and allows commenting on it.
+	MemoSharePermissionComment MemoSharePermission = "COMMENT"
+	// MemoSharePermissionEdit grants read and comment access to the memo and allows editing its content.
+	MemoSharePermissionEdit MemoSharePermission = "EDIT"
+)
+
+func (p MemoSharePermission) String() string {
+	return string(p)
+}
+
+// MemoShare grants a user access to a memo regardless of the visibility of the memo.
+type MemoShare struct {
+	ID        int32
+	MemoID    int32
+	CreatorID int32
+	// UserID is the ID of the user that the memo is shared with.
+	UserID     int32
+	CreatedTs  int64
+	Permission MemoSharePermission
+}
+
+type FindMemoShare struct {
+	ID     *int32
+	MemoID *int32
+	UserID *int32
+}
+
+type DeleteMemoShare struct {
+	ID     *int32
+	MemoID *int32
+}
+
+// UpsertMemoShare shares the memo with the user, or updates the permission if the memo is already shared with them.
+func (s *Store) UpsertMemoShare(ctx context.Context, upsert *MemoShare) (*MemoShare, error) {
+	return s.driver.UpsertMemoShare(ctx, upsert)
+}
+
+func (s *Store) ListMemoShares(ctx context.Context, find *FindMemoShare) ([]*MemoShare, error) {
+	return s.driver.ListMemoShares(ctx, find)
+}
+
+func (s *Store) GetMemoShare(ctx context.Context, find *FindMemoShare) (*MemoShare, error) {
+	list, err := s.ListMemoShares(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoShare(ctx context.Context, delete *DeleteMemoShare) error {
+	return s.driver.DeleteMemoShare(ctx, delete)
+}
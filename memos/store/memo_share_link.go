@@ -0,0 +1,53 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoShareLink is an unlisted link to a memo, which grants anyone with its token read access to the memo and its resources.
+type MemoShareLink struct {
+	ID        int32
+	MemoID    int32
+	CreatorID int32
+	CreatedTs int64
+	Token     string
+	// PasswordHash is the bcrypt hash of the password of the link, or empty if the link has no password.
+	PasswordHash string
+	// ExpireTs is the time the link expires at, or 0 if it never expires.
+	ExpireTs int64
+}
+
+type FindMemoShareLink struct {
+	ID        *int32
+	MemoID    *int32
+	CreatorID *int32
+	Token     *string
+}
+
+type DeleteMemoShareLink struct {
+	ID     *int32
+	MemoID *int32
+}
+
+func (s *Store) CreateMemoShareLink(ctx context.Context, create *MemoShareLink) (*MemoShareLink, error) {
+	return s.driver.CreateMemoShareLink(ctx, create)
+}
+
+func (s *Store) ListMemoShareLinks(ctx context.Context, find *FindMemoShareLink) ([]*MemoShareLink, error) {
+	return s.driver.ListMemoShareLinks(ctx, find)
+}
+
+func (s *Store) GetMemoShareLink(ctx context.Context, find *FindMemoShareLink) (*MemoShareLink, error) {
+	list, err := s.ListMemoShareLinks(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoShareLink(ctx context.Context, delete *DeleteMemoShareLink) error {
+	return s.driver.DeleteMemoShareLink(ctx, delete)
+}
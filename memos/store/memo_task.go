@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoTask is a task list item of a memo, indexed from the content of the memo.
+type MemoTask struct {
+	ID        int32
+	MemoID    int32
+	CreatorID int32
+	// Position is the index of the task among the tasks of the memo, in the order of the content.
+	Position  int32
+	Content   string
+	Completed bool
+	// DueTs is the due time of the task, if any.
+	DueTs *int64
+}
+
+type FindMemoTask struct {
+	ID        *int32
+	MemoID    *int32
+	CreatorID *int32
+	Position  *int32
+	Completed *bool
+
+	// HasDue finds the tasks with or without a due time.
+	HasDue      *bool
+	DueTsBefore *int64
+	DueTsAfter  *int64
+
+	ContentSearch []string
+	// MemoRowStatus finds the tasks of the memos with the row status.
+	// The tasks of the memos in the trash bin are never found.
+	MemoRowStatus *RowStatus
+}
+
+type DeleteMemoTask struct {
+	MemoID int32
+}
+
+func (s *Store) CreateMemoTask(ctx context.Context, create *MemoTask) (*MemoTask, error) {
+	return s.driver.CreateMemoTask(ctx, create)
+}
+
+func (s *Store) ListMemoTasks(ctx context.Context, find *FindMemoTask) ([]*MemoTask, error) {
+	return s.driver.ListMemoTasks(ctx, find)
+}
+
+func (s *Store) GetMemoTask(ctx context.Context, find *FindMemoTask) (*MemoTask, error) {
+	list, err := s.ListMemoTasks(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) DeleteMemoTask(ctx context.Context, delete *DeleteMemoTask) error {
+	return s.driver.DeleteMemoTask(ctx, delete)
+}
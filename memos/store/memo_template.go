@@ -0,0 +1,82 @@
+package store
+
+import (
+	"context"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// MemoTemplateScope is the scope of a memo template.
+type MemoTemplateScope string
+
+const (
+	// MemoTemplateScopeUser is the scope of a template that is only available to its creator.
+	MemoTemplateScopeUser MemoTemplateScope = "USER"
+	// MemoTemplateScopeWorkspace is the scope of a template that is shared with the workspace by an admin.
+	MemoTemplateScopeWorkspace MemoTemplateScope = "WORKSPACE"
+)
+
+func (s MemoTemplateScope) String() string {
+	return string(s)
+}
+
+// MemoTemplate is a skeleton that memos are created from.
+type MemoTemplate struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+	UpdatedTs int64
+
+	Scope   MemoTemplateScope
+	Title   string
+	Content string
+	// Visibility is the default visibility of the memos created from the template.
+	Visibility Visibility
+	Payload    *storepb.MemoTemplatePayload
+}
+
+type FindMemoTemplate struct {
+	ID        *int32
+	CreatorID *int32
+	Scope     *MemoTemplateScope
+}
+
+type UpdateMemoTemplate struct {
+	ID         int32
+	UpdatedTs  *int64
+	Title      *string
+	Content    *string
+	Visibility *Visibility
+	Payload    *storepb.MemoTemplatePayload
+}
+
+type DeleteMemoTemplate struct {
+	ID int32
+}
+
+func (s *Store) CreateMemoTemplate(ctx context.Context, create *MemoTemplate) (*MemoTemplate, error) {
+	return s.driver.CreateMemoTemplate(ctx, create)
+}
+
+func (s *Store) ListMemoTemplates(ctx context.Context, find *FindMemoTemplate) ([]*MemoTemplate, error) {
+	return s.driver.ListMemoTemplates(ctx, find)
+}
+
+func (s *Store) GetMemoTemplate(ctx context.Context, find *FindMemoTemplate) (*MemoTemplate, error) {
+	list, err := s.ListMemoTemplates(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateMemoTemplate(ctx context.Context, update *UpdateMemoTemplate) (*MemoTemplate, error) {
+	return s.driver.UpdateMemoTemplate(ctx, update)
+}
+
+func (s *Store) DeleteMemoTemplate(ctx context.Context, delete *DeleteMemoTemplate) error {
+	return s.driver.DeleteMemoTemplate(ctx, delete)
+}
@@ -0,0 +1,223 @@
+package store
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultMemoTrashRetentionDays is the default number of days memos are kept in the trash bin.
+	DefaultMemoTrashRetentionDays = 30
+)
+
+// TrashMemo moves the memo along with its resources and comments into the trash bin.
+func (s *Store) TrashMemo(ctx context.Context, id int32) error {
+	deletedTs := time.Now().Unix()
+	return s.WithTx(ctx, func(txStore *Store) error {
+		return txStore.trashMemo(ctx, id, deletedTs, nil)
+	})
+}
+
+// TrashMemoIfUnchanged is TrashMemo that returns ErrMemoChanged if the memo has been updated since updatedTs.
+func (s *Store) TrashMemoIfUnchanged(ctx context.Context, id int32, updatedTs int64) error {
+	deletedTs := time.Now().Unix()
+	return s.WithTx(ctx, func(txStore *Store) error {
+		return txStore.trashMemo(ctx, id, deletedTs, &updatedTs)
+	})
+}
+
+func (s *Store) trashMemo(ctx context.Context, id int32, deletedTs int64, expectedUpdatedTs *int64) error {
+	memo, err := s.GetMemo(ctx, &FindMemo{ID: &id})
+	if err != nil {
+		return errors.Wrap(err, "failed to get memo")
+	}
+	// The memo is already in the trash bin.
+	if memo == nil {
+		return nil
+	}
+
+	commentType := MemoRelationComment
+	relations, err := s.ListMemoRelations(ctx, &FindMemoRelation{RelatedMemoID: &id, Type: &commentType})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo comments")
+	}
+	for _, relation := range relations {
+		if err := s.trashMemo(ctx, relation.MemoID, deletedTs, nil); err != nil {
+			return err
+		}
+	}
+
+	resources, err := s.ListResources(ctx, &FindResource{MemoID: &id})
+	if err != nil {
+		return errors.Wrap(err, "failed to list resources")
+	}
+	for _, resource := range resources {
+		if err := s.UpdateResource(ctx, &UpdateResource{ID: resource.ID, DeletedTs: &deletedTs}); err != nil {
+			return errors.Wrap(err, "failed to trash resource")
+		}
+	}
+
+	if err := s.UpdateMemo(ctx, &UpdateMemo{ID: id, DeletedTs: &deletedTs, ExpectedUpdatedTs: expectedUpdatedTs}); err != nil {
+		return errors.Wrap(err, "failed to trash memo")
+	}
+	return nil
+}
+
+// RestoreMemo moves the memo out of the trash bin, along with the resources and comments
+// that were trashed together with it.
+func (s *Store) RestoreMemo(ctx context.Context, id int32) error {
+	return s.WithTx(ctx, func(txStore *Store) error {
+		memo, err := txStore.GetMemo(ctx, &FindMemo{ID: &id, Trashed: true})
+		if err != nil {
+			return errors.Wrap(err, "failed to get memo")
+		}
+		if memo == nil {
+			return errors.New("memo not found in the trash bin")
+		}
+		return txStore.restoreMemo(ctx, memo)
+	})
+}
+
+func (s *Store) restoreMemo(ctx context.Context, memo *Memo) error {
+	restoredTs := int64(0)
+	commentType := MemoRelationComment
+	relations, err := s.ListMemoRelations(ctx, &FindMemoRelation{RelatedMemoID: &memo.ID, Type: &commentType})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memo comments")
+	}
+	for _, relation := range relations {
+		comment, err := s.GetMemo(ctx, &FindMemo{ID: &relation.MemoID, Trashed: true})
+		if err != nil {
+			return errors.Wrap(err, "failed to get memo comment")
+		}
+		if comment == nil || comment.DeletedTs != memo.DeletedTs {
+			continue
+		}
+		if err := s.restoreMemo(ctx, comment); err != nil {
+			return err
+		}
+	}
+
+	resources, err := s.ListResources(ctx, &FindResource{MemoID: &memo.ID, Trashed: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to list resources")
+	}
+	for _, resource := range resources {
+		if resource.DeletedTs != memo.DeletedTs {
+			continue
+		}
+		if err := s.UpdateResource(ctx, &UpdateResource{ID: resource.ID, DeletedTs: &restoredTs}); err != nil {
+			return errors.Wrap(err, "failed to restore resource")
+		}
+	}
+
+	if err := s.UpdateMemo(ctx, &UpdateMemo{ID: memo.ID, DeletedTs: &restoredTs}); err != nil {
+		return errors.Wrap(err, "failed to restore memo")
+	}
+	return nil
+}
+
+// PurgeMemo permanently deletes the memo along with its resources, revisions, relations and comments.
+// The files of the resources are deleted once the database changes are committed.
+func (s *Store) PurgeMemo(ctx context.Context, id int32) error {
+	resources := []*Resource{}
+	if err := s.WithTx(ctx, func(txStore *Store) error {
+		purgedResources, err := txStore.purgeMemo(ctx, id)
+		resources = purgedResources
+		return err
+	}); err != nil {
+		return err
+	}
+
+	for _, resource := range resources {
+		if err := s.DeleteResourceFile(ctx, resource); err != nil {
+			slog.Warn("Failed to delete resource file", slog.Any("err", err))
+		}
+	}
+	return nil
+}
+
+// purgeMemo deletes the memo and returns the deleted resources.
+func (s *Store) purgeMemo(ctx context.Context, id int32) ([]*Resource, error) {
+	commentType := MemoRelationComment
+	relations, err := s.ListMemoRelations(ctx, &FindMemoRelation{RelatedMemoID: &id, Type: &commentType})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo comments")
+	}
+	purgedResources := []*Resource{}
+	for _, relation := range relations {
+		resources, err := s.purgeMemo(ctx, relation.MemoID)
+		if err != nil {
+			return nil, err
+		}
+		purgedResources = append(purgedResources, resources...)
+	}
+
+	resources, err := s.ListResources(ctx, &FindResource{MemoID: &id, IncludeTrashed: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list resources")
+	}
+	for _, resource := range resources {
+		if err := s.driver.DeleteResource(ctx, &DeleteResource{ID: resource.ID}); err != nil {
+			return nil, errors.Wrap(err, "failed to delete resource")
+		}
+		purgedResources = append(purgedResources, resource)
+	}
+
+	if err := s.DeleteMemoRelation(ctx, &DeleteMemoRelation{MemoID: &id}); err != nil {
+		return nil, errors.Wrap(err, "failed to delete memo relations")
+	}
+	referenceType := MemoRelationReference
+	if err := s.DeleteMemoRelation(ctx, &DeleteMemoRelation{RelatedMemoID: &id, Type: &referenceType}); err != nil {
+		return nil, errors.Wrap(err, "failed to delete memo references")
+	}
+	if err := s.DeleteMemoRevision(ctx, &DeleteMemoRevision{MemoID: &id}); err != nil {
+		return nil, errors.Wrap(err, "failed to delete memo revisions")
+	}
+	if err := s.DeleteMemoTask(ctx, &DeleteMemoTask{MemoID: id}); err != nil {
+		return nil, errors.Wrap(err, "failed to delete memo tasks")
+	}
+	if err := s.DeleteMemoReminder(ctx, &DeleteMemoReminder{MemoID: &id}); err != nil {
+		return nil, errors.Wrap(err, "failed to delete memo reminders")
+	}
+	if err := s.DeleteMemoShareLink(ctx, &DeleteMemoShareLink{MemoID: &id}); err != nil {
+		return nil, errors.Wrap(err, "failed to delete memo share links")
+	}
+	if err := s.DeleteMemoShare(ctx, &DeleteMemoShare{MemoID: &id}); err != nil {
+		return nil, errors.Wrap(err, "failed to delete memo shares")
+	}
+	if err := s.DeleteCollectionMemo(ctx, &DeleteCollectionMemo{MemoID: &id}); err != nil {
+		return nil, errors.Wrap(err, "failed to delete collection memos")
+	}
+	if err := s.DeleteMemo(ctx, &DeleteMemo{ID: id}); err != nil {
+		return nil, errors.Wrap(err, "failed to delete memo")
+	}
+	return purgedResources, nil
+}
+
+// PurgeTrashedMemos permanently deletes the memos that have been in the trash bin for longer than the retention period.
+func (s *Store) PurgeTrashedMemos(ctx context.Context) error {
+	workspaceMemoRelatedSetting, err := s.GetWorkspaceMemoRelatedSetting(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get workspace memo related setting")
+	}
+	retention := time.Duration(workspaceMemoRelatedSetting.TrashRetentionDays) * 24 * time.Hour
+	deletedTsBefore := time.Now().Add(-retention).Unix()
+	memos, err := s.ListMemos(ctx, &FindMemo{
+		Trashed:         true,
+		DeletedTsBefore: &deletedTsBefore,
+		ExcludeContent:  true,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list trashed memos")
+	}
+	for _, memo := range memos {
+		if err := s.PurgeMemo(ctx, memo.ID); err != nil {
+			return errors.Wrapf(err, "failed to purge memo %d", memo.ID)
+		}
+	}
+	return nil
+}
@@ -20,6 +20,8 @@ type FindReaction struct {
 	ID        *int32
 	CreatorID *int32
 	ContentID *string
+	// ContentIDList batch loads the reactions of many contents.
+	ContentIDList []string
 }
 
 type DeleteReaction struct {
@@ -0,0 +1,88 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// RecurringMemoState is the state of a recurring memo.
+type RecurringMemoState string
+
+const (
+	// RecurringMemoStateActive is the state of a recurring memo that runs on schedule.
+	RecurringMemoStateActive RecurringMemoState = "ACTIVE"
+	// RecurringMemoStatePaused is the state of a recurring memo that is skipped until resumed.
+	RecurringMemoStatePaused RecurringMemoState = "PAUSED"
+)
+
+func (s RecurringMemoState) String() string {
+	return string(s)
+}
+
+// RecurringMemo creates memos or reminders of its creator on the schedule of a cron expression.
+type RecurringMemo struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+	UpdatedTs int64
+
+	State          RecurringMemoState
+	CronExpression string
+	// Timezone is the IANA name of the timezone the cron expression is evaluated in.
+	Timezone string
+	// LastRunTs is the time of the last run, which is 0 if it never ran.
+	LastRunTs int64
+	Payload   *storepb.RecurringMemoPayload
+}
+
+type FindRecurringMemo struct {
+	ID        *int32
+	CreatorID *int32
+	State     *RecurringMemoState
+}
+
+type UpdateRecurringMemo struct {
+	ID        int32
+	UpdatedTs *int64
+	State     *RecurringMemoState
+	LastRunTs *int64
+	// ExpectedLastRunTs makes the update only apply if the recurring memo has not run since.
+	// ErrRecurringMemoChanged is returned otherwise.
+	ExpectedLastRunTs *int64
+}
+
+// ErrRecurringMemoChanged is returned by conditional updates of recurring memos that have run meanwhile.
+var ErrRecurringMemoChanged = errors.New("recurring memo has been changed")
+
+type DeleteRecurringMemo struct {
+	ID int32
+}
+
+func (s *Store) CreateRecurringMemo(ctx context.Context, create *RecurringMemo) (*RecurringMemo, error) {
+	return s.driver.CreateRecurringMemo(ctx, create)
+}
+
+func (s *Store) ListRecurringMemos(ctx context.Context, find *FindRecurringMemo) ([]*RecurringMemo, error) {
+	return s.driver.ListRecurringMemos(ctx, find)
+}
+
+func (s *Store) GetRecurringMemo(ctx context.Context, find *FindRecurringMemo) (*RecurringMemo, error) {
+	list, err := s.ListRecurringMemos(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateRecurringMemo(ctx context.Context, update *UpdateRecurringMemo) (*RecurringMemo, error) {
+	return s.driver.UpdateRecurringMemo(ctx, update)
+}
+
+func (s *Store) DeleteRecurringMemo(ctx context.Context, delete *DeleteRecurringMemo) error {
+	return s.driver.DeleteRecurringMemo(ctx, delete)
+}
@@ -23,6 +23,8 @@ type Resource struct {
 	CreatorID int32
 	CreatedTs int64
 	UpdatedTs int64
+	// DeletedTs is the time when the resource was moved into the trash bin along with its memo.
+	DeletedTs int64
 
 	// Domain specific fields
 	Filename    string
@@ -38,22 +40,36 @@ type Resource struct {
 }
 
 type FindResource struct {
-	GetBlob        bool
-	ID             *int32
-	UID            *string
-	CreatorID      *int32
-	Filename       *string
-	MemoID         *int32
+	GetBlob   bool
+	ID        *int32
+	UID       *string
+	CreatorID *int32
+	Filename  *string
+	MemoID    *int32
+	// MemoIDList batch loads the resources of many memos.
+	MemoIDList     []int32
 	HasRelatedMemo bool
 	StorageType    *storepb.ResourceStorageType
+	// Resources in the trash bin are excluded unless Trashed or IncludeTrashed is set.
+	Trashed        bool
+	IncludeTrashed bool
 	Limit          *int
 	Offset         *int
+	// Cursor finds the resources that come after it in the list order.
+	Cursor *ResourceCursor
+}
+
+// ResourceCursor is the position of a resource in the list order, used for keyset pagination.
+type ResourceCursor struct {
+	UpdatedTs int64
+	ID        int32
 }
 
 type UpdateResource struct {
 	ID        int32
 	UID       *string
 	UpdatedTs *int64
+	DeletedTs *int64
 	Filename  *string
 	MemoID    *int32
 	Reference *string
@@ -96,8 +112,9 @@ func (s *Store) UpdateResource(ctx context.Context, update *UpdateResource) erro
 	return s.driver.UpdateResource(ctx, update)
 }
 
+// DeleteResource deletes the resource, whose file is deleted once the database change is committed.
 func (s *Store) DeleteResource(ctx context.Context, delete *DeleteResource) error {
-	resource, err := s.GetResource(ctx, &FindResource{ID: &delete.ID})
+	resource, err := s.GetResource(ctx, &FindResource{ID: &delete.ID, IncludeTrashed: true})
 	if err != nil {
 		return errors.Wrap(err, "failed to get resource")
 	}
@@ -105,6 +122,19 @@ func (s *Store) DeleteResource(ctx context.Context, delete *DeleteResource) erro
 		return errors.Wrap(nil, "resource not found")
 	}
 
+	if err := s.driver.DeleteResource(ctx, delete); err != nil {
+		return err
+	}
+
+	if err := s.DeleteResourceFile(ctx, resource); err != nil {
+		slog.Warn("Failed to delete resource file", slog.Any("err", err))
+	}
+	return nil
+}
+
+// DeleteResourceFile deletes the file of the resource kept in the local or S3 storage.
+// It is also used to clean up the files saved for resources that failed to be created.
+func (s *Store) DeleteResourceFile(ctx context.Context, resource *Resource) error {
 	if resource.StorageType == storepb.ResourceStorageType_LOCAL {
 		if err := func() error {
 			p := filepath.FromSlash(resource.Reference)
@@ -149,6 +179,5 @@ func (s *Store) DeleteResource(ctx context.Context, delete *DeleteResource) erro
 			slog.Warn("Failed to delete s3 object", err)
 		}
 	}
-
-	return s.driver.DeleteResource(ctx, delete)
+	return nil
 }
@@ -25,6 +25,22 @@ func New(driver Driver, profile *profile.Profile) *Store {
 	}
 }
 
+// WithTx runs fn with a store whose queries are executed within a transaction.
+// The transaction is committed if fn returns nil and rolled back otherwise.
+// fn must only use txStore, as queries issued on other stores are not part of the transaction.
+func (s *Store) WithTx(ctx context.Context, fn func(txStore *Store) error) error {
+	if err := s.driver.WithTx(ctx, func(driver Driver) error {
+		// The transaction store starts with empty caches, so that entries
+		// written in a rolled back transaction never leak into the store.
+		return fn(New(driver, s.Profile))
+	}); err != nil {
+		return err
+	}
+	// Drop the cached entries, as they may have been changed in the transaction.
+	s.resetCaches()
+	return nil
+}
+
 func (*Store) MigrateManually(context.Context) error {
 	return nil
 }
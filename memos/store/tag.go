@@ -0,0 +1,71 @@
+package store
+
+import (
+	"context"
+)
+
+// Tag holds the metadata of a tag of a user. The tags of memos are still derived from their content,
+// so a tag may be used by memos without having a Tag.
+type Tag struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+	UpdatedTs int64
+
+	// Name is the full name of the tag without the leading "#", e.g. "project/alpha".
+	Name        string
+	Color       string
+	Emoji       string
+	Description string
+	Pinned      bool
+	SortOrder   int32
+}
+
+type FindTag struct {
+	ID        *int32
+	CreatorID *int32
+	Name      *string
+	NameList  []string
+}
+
+type UpdateTag struct {
+	ID          int32
+	UpdatedTs   *int64
+	Name        *string
+	Color       *string
+	Emoji       *string
+	Description *string
+	Pinned      *bool
+	SortOrder   *int32
+}
+
+type DeleteTag struct {
+	ID int32
+}
+
+func (s *Store) CreateTag(ctx context.Context, create *Tag) (*Tag, error) {
+	return s.driver.CreateTag(ctx, create)
+}
+
+func (s *Store) ListTags(ctx context.Context, find *FindTag) ([]*Tag, error) {
+	return s.driver.ListTags(ctx, find)
+}
+
+func (s *Store) GetTag(ctx context.Context, find *FindTag) (*Tag, error) {
+	list, err := s.ListTags(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateTag(ctx context.Context, update *UpdateTag) (*Tag, error) {
+	return s.driver.UpdateTag(ctx, update)
+}
+
+func (s *Store) DeleteTag(ctx context.Context, delete *DeleteTag) error {
+	return s.driver.DeleteTag(ctx, delete)
+}
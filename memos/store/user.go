@@ -77,6 +77,7 @@ type UpdateUser struct {
 
 type FindUser struct {
 	ID        *int32
+	IDList    []int32
 	RowStatus *RowStatus
 	Username  *string
 	Role      *Role
@@ -151,6 +151,14 @@ func convertUserSettingFromRaw(raw *UserSetting) (*storepb.UserSetting, error) {
 		userSetting.Value = &storepb.UserSetting_Appearance{Appearance: raw.Value}
 	case storepb.UserSettingKey_MEMO_VISIBILITY:
 		userSetting.Value = &storepb.UserSetting_MemoVisibility{MemoVisibility: raw.Value}
+	case storepb.UserSettingKey_DAILY_JOURNAL:
+		dailyJournalUserSetting := &storepb.DailyJournalUserSetting{}
+		if err := protojsonUnmarshaler.Unmarshal([]byte(raw.Value), dailyJournalUserSetting); err != nil {
+			return nil, err
+		}
+		userSetting.Value = &storepb.UserSetting_DailyJournal{DailyJournal: dailyJournalUserSetting}
+	case storepb.UserSettingKey_TIMEZONE:
+		userSetting.Value = &storepb.UserSetting_Timezone{Timezone: raw.Value}
 	default:
 		return nil, nil
 	}
@@ -177,6 +185,14 @@ func convertUserSettingToRaw(userSetting *storepb.UserSetting) (*UserSetting, er
 		raw.Value = userSetting.GetAppearance()
 	case storepb.UserSettingKey_MEMO_VISIBILITY:
 		raw.Value = userSetting.GetMemoVisibility()
+	case storepb.UserSettingKey_DAILY_JOURNAL:
+		value, err := protojson.Marshal(userSetting.GetDailyJournal())
+		if err != nil {
+			return nil, err
+		}
+		raw.Value = string(value)
+	case storepb.UserSettingKey_TIMEZONE:
+		raw.Value = userSetting.GetTimezone()
 	default:
 		return nil, errors.Errorf("unsupported user setting key: %v", userSetting.Key)
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
@@ -140,6 +141,8 @@ func (s *Store) GetWorkspaceGeneralSetting(ctx context.Context) (*storepb.Worksp
 const (
 	// DefaultContentLengthLimit is the default limit of content length in bytes. 8KB.
 	DefaultContentLengthLimit = 8 * 1024
+	// DefaultMemoRevisionLimit is the default number of revisions kept for each memo.
+	DefaultMemoRevisionLimit = 20
 )
 
 func (s *Store) GetWorkspaceMemoRelatedSetting(ctx context.Context) (*storepb.WorkspaceMemoRelatedSetting, error) {
@@ -157,6 +160,12 @@ func (s *Store) GetWorkspaceMemoRelatedSetting(ctx context.Context) (*storepb.Wo
 	if workspaceMemoRelatedSetting.ContentLengthLimit < DefaultContentLengthLimit {
 		workspaceMemoRelatedSetting.ContentLengthLimit = DefaultContentLengthLimit
 	}
+	if workspaceMemoRelatedSetting.MemoRevisionLimit == nil || workspaceMemoRelatedSetting.GetMemoRevisionLimit() < 0 {
+		workspaceMemoRelatedSetting.MemoRevisionLimit = proto.Int32(DefaultMemoRevisionLimit)
+	}
+	if workspaceMemoRelatedSetting.TrashRetentionDays <= 0 {
+		workspaceMemoRelatedSetting.TrashRetentionDays = DefaultMemoTrashRetentionDays
+	}
 	s.workspaceSettingCache.Store(storepb.WorkspaceSettingKey_MEMO_RELATED.String(), &storepb.WorkspaceSetting{
 		Key:   storepb.WorkspaceSettingKey_MEMO_RELATED,
 		Value: &storepb.WorkspaceSetting_MemoRelatedSetting{MemoRelatedSetting: workspaceMemoRelatedSetting},
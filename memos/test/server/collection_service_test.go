@@ -0,0 +1,138 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestCollectionService(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	ts := s.Store
+	user, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	other, otherCtx := s.CreateUser(ctx, t, "other", store.RoleUser)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	collectionService := v1pb.NewCollectionServiceClient(s.Conn)
+
+	memoNames := []string{}
+	for i, visibility := range []store.Visibility{store.Public, store.Private, store.Protected} {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("collection-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("memo %d", i),
+			Visibility: visibility,
+		})
+		require.NoError(t, err)
+		memoNames = append(memoNames, fmt.Sprintf("%s%d", apiv1.MemoNamePrefix, memo.ID))
+	}
+	otherMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "collection-memo-other",
+		CreatorID:  other.ID,
+		Content:    "private memo of other",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	_, err = collectionService.CreateCollection(userCtx, &v1pb.CreateCollectionRequest{
+		Collection: &v1pb.Collection{},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	collection, err := collectionService.CreateCollection(userCtx, &v1pb.CreateCollectionRequest{
+		Collection: &v1pb.Collection{Title: "Trip", Description: "Notes of the trip"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PRIVATE, collection.Visibility)
+
+	// Memos are kept in the given order, and the memos the user can't see are rejected.
+	_, err = collectionService.SetCollectionMemos(userCtx, &v1pb.SetCollectionMemosRequest{
+		Name:  collection.Name,
+		Memos: []string{memoNames[0], fmt.Sprintf("%s%d", apiv1.MemoNamePrefix, otherMemo.ID)},
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = collectionService.SetCollectionMemos(userCtx, &v1pb.SetCollectionMemosRequest{
+		Name:  collection.Name,
+		Memos: []string{memoNames[0], memoNames[0]},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = collectionService.SetCollectionMemos(userCtx, &v1pb.SetCollectionMemosRequest{
+		Name:  collection.Name,
+		Memos: []string{memoNames[2], memoNames[0], memoNames[1]},
+	})
+	require.NoError(t, err)
+	response, err := collectionService.ListCollectionMemos(userCtx, &v1pb.ListCollectionMemosRequest{Name: collection.Name})
+	require.NoError(t, err)
+	require.Equal(t, []string{memoNames[2], memoNames[0], memoNames[1]}, getMemoNames(response.Memos))
+
+	// Private collections are hidden from other users.
+	_, err = collectionService.GetCollection(otherCtx, &v1pb.GetCollectionRequest{Name: collection.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = memoService.ListMemos(otherCtx, &v1pb.ListMemosRequest{Filter: fmt.Sprintf("collection == %q", collection.Name)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = collectionService.SetCollectionMemos(otherCtx, &v1pb.SetCollectionMemosRequest{Name: collection.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	collection, err = collectionService.UpdateCollection(userCtx, &v1pb.UpdateCollectionRequest{
+		Collection: &v1pb.Collection{Name: collection.Name, Visibility: v1pb.Visibility_PUBLIC},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Trip", collection.Title)
+	require.Equal(t, v1pb.Visibility_PUBLIC, collection.Visibility)
+
+	// The memos of a visible collection are still filtered by their own visibility.
+	response, err = collectionService.ListCollectionMemos(otherCtx, &v1pb.ListCollectionMemosRequest{Name: collection.Name})
+	require.NoError(t, err)
+	require.Equal(t, []string{memoNames[2], memoNames[0]}, getMemoNames(response.Memos))
+	// Pages skip the memos the user can't see.
+	response, err = collectionService.ListCollectionMemos(otherCtx, &v1pb.ListCollectionMemosRequest{Name: collection.Name, PageSize: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{memoNames[2]}, getMemoNames(response.Memos))
+	require.NotEmpty(t, response.NextPageToken)
+	response, err = collectionService.ListCollectionMemos(otherCtx, &v1pb.ListCollectionMemosRequest{Name: collection.Name, PageSize: 1, PageToken: response.NextPageToken})
+	require.NoError(t, err)
+	require.Equal(t, []string{memoNames[0]}, getMemoNames(response.Memos))
+	require.Empty(t, response.NextPageToken)
+	response, err = collectionService.ListCollectionMemos(ctx, &v1pb.ListCollectionMemosRequest{Name: collection.Name})
+	require.NoError(t, err)
+	require.Equal(t, []string{memoNames[0]}, getMemoNames(response.Memos))
+	listResponse, err := memoService.ListMemos(otherCtx, &v1pb.ListMemosRequest{Filter: fmt.Sprintf("collection == %q", collection.Name)})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(listResponse.Memos))
+	collectionsResponse, err := collectionService.ListCollections(otherCtx, &v1pb.ListCollectionsRequest{Creator: fmt.Sprintf("%s%d", apiv1.UserNamePrefix, user.ID)})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collectionsResponse.Collections))
+	collectionsResponse, err = collectionService.ListCollections(otherCtx, &v1pb.ListCollectionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(collectionsResponse.Collections))
+
+	_, err = collectionService.UpdateCollection(otherCtx, &v1pb.UpdateCollectionRequest{
+		Collection: &v1pb.Collection{Name: collection.Name, Title: "Mine"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = collectionService.DeleteCollection(userCtx, &v1pb.DeleteCollectionRequest{Name: collection.Name})
+	require.NoError(t, err)
+	_, err = collectionService.GetCollection(userCtx, &v1pb.GetCollectionRequest{Name: collection.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	// Deleting a collection keeps its memos.
+	_, err = memoService.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memoNames[1]})
+	require.NoError(t, err)
+}
+
+func getMemoNames(memos []*v1pb.Memo) []string {
+	names := []string{}
+	for _, memo := range memos {
+		names = append(names, memo.Name)
+	}
+	return names
+}
@@ -0,0 +1,104 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestCreateDueDailyJournals(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	ts := s.Store
+	user, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	userService := v1pb.NewUserServiceClient(s.Conn)
+	templateService := v1pb.NewMemoTemplateServiceClient(s.Conn)
+	template, err := templateService.CreateMemoTemplate(userCtx, &v1pb.CreateMemoTemplateRequest{
+		Template: &v1pb.MemoTemplate{Title: "Journal", Content: "# {{date}}", Tags: []string{"journal"}},
+	})
+	require.NoError(t, err)
+
+	updateMask := &fieldmaskpb.FieldMask{Paths: []string{"daily_journal"}}
+	_, err = userService.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting:    &v1pb.UserSetting{DailyJournal: &v1pb.UserSetting_DailyJournal{Enabled: true, Time: "8am", Template: template.Name}},
+		UpdateMask: updateMask,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = userService.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting:    &v1pb.UserSetting{DailyJournal: &v1pb.UserSetting_DailyJournal{Enabled: true, Time: "08:00", Timezone: "Mars/Olympus", Template: template.Name}},
+		UpdateMask: updateMask,
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	setting, err := userService.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting:    &v1pb.UserSetting{DailyJournal: &v1pb.UserSetting_DailyJournal{Enabled: true, Time: "08:00", Timezone: "Asia/Tokyo", Template: template.Name}},
+		UpdateMask: updateMask,
+	})
+	require.NoError(t, err)
+	require.Equal(t, template.Name, setting.DailyJournal.Template)
+
+	listJournals := func() []*store.Memo {
+		memos, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+		require.NoError(t, err)
+		return memos
+	}
+	// 07:30 in Tokyo is before the journal time.
+	require.NoError(t, s.Service.CreateDueDailyJournals(ctx, time.Date(2024, 5, 31, 22, 30, 0, 0, time.UTC)))
+	require.Equal(t, 0, len(listJournals()))
+	// 08:30 in Tokyo, while it is still the previous day in UTC.
+	require.NoError(t, s.Service.CreateDueDailyJournals(ctx, time.Date(2024, 5, 31, 23, 30, 0, 0, time.UTC)))
+	journals := listJournals()
+	require.Equal(t, 1, len(journals))
+	require.Equal(t, "# 2024-06-01\n\n#journal", journals[0].Content)
+	firstJournal := journals[0]
+	// The journal is created once per day.
+	require.NoError(t, s.Service.CreateDueDailyJournals(ctx, time.Date(2024, 6, 1, 5, 0, 0, 0, time.UTC)))
+	require.Equal(t, 1, len(listJournals()))
+
+	require.NoError(t, s.Service.CreateDueDailyJournals(ctx, time.Date(2024, 6, 1, 23, 0, 0, 0, time.UTC)))
+	journals = listJournals()
+	require.Equal(t, 2, len(journals))
+	secondJournal := journals[0]
+	if secondJournal.ID == firstJournal.ID {
+		secondJournal = journals[1]
+	}
+	referenceType := store.MemoRelationReference
+	relations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoID: &secondJournal.ID,
+		Type:   &referenceType,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(relations))
+	require.Equal(t, firstJournal.ID, relations[0].RelatedMemoID)
+
+	setting, err = userService.GetUserSetting(userCtx, &v1pb.GetUserSettingRequest{})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%s%d", apiv1.MemoNamePrefix, secondJournal.ID), setting.DailyJournal.LastMemo)
+
+	// Skipping a day leaves the journal without a link.
+	require.NoError(t, s.Service.CreateDueDailyJournals(ctx, time.Date(2024, 6, 3, 23, 0, 0, 0, time.UTC)))
+	journals = listJournals()
+	require.Equal(t, 3, len(journals))
+	relations, err = ts.ListMemoRelations(ctx, &store.FindMemoRelation{Type: &referenceType})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(relations))
+
+	// A journal that fails to be created is not retried on the same day.
+	_, err = templateService.DeleteMemoTemplate(userCtx, &v1pb.DeleteMemoTemplateRequest{Name: template.Name})
+	require.NoError(t, err)
+	require.NoError(t, s.Service.CreateDueDailyJournals(ctx, time.Date(2024, 6, 4, 23, 0, 0, 0, time.UTC)))
+	require.NoError(t, s.Service.CreateDueDailyJournals(ctx, time.Date(2024, 6, 5, 1, 0, 0, 0, time.UTC)))
+	require.Equal(t, 3, len(listJournals()))
+	setting, err = userService.GetUserSetting(userCtx, &v1pb.GetUserSettingRequest{})
+	require.NoError(t, err)
+	require.Empty(t, setting.DailyJournal.LastMemo)
+}
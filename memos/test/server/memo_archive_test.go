@@ -0,0 +1,143 @@
+package testserver
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestWriteMemoArchive(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	ts := s.Store
+	user, _ := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "memo #tag",
+		Visibility: store.Public,
+		Payload: &storepb.MemoPayload{
+			Property: &storepb.MemoPayload_Property{Tags: []string{"tag"}},
+		},
+	})
+	require.NoError(t, err)
+	comment, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-comment",
+		CreatorID:  user.ID,
+		Content:    "comment",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        comment.ID,
+		RelatedMemoID: memo.ID,
+		Type:          store.MemoRelationComment,
+	})
+	require.NoError(t, err)
+	// The comments of other users on the memos are archived as well.
+	otherUser, _ := s.CreateUser(ctx, t, "other", store.RoleUser)
+	otherComment, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "other-comment",
+		CreatorID:  otherUser.ID,
+		Content:    "other comment",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        otherComment.ID,
+		RelatedMemoID: memo.ID,
+		Type:          store.MemoRelationComment,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateResource(ctx, &store.Resource{
+		UID:       "test-resource",
+		CreatorID: user.ID,
+		Filename:  "hello.txt",
+		Blob:      []byte("hello world"),
+		Type:      "text/plain",
+		Size:      11,
+		MemoID:    &memo.ID,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertReaction(ctx, &store.Reaction{
+		CreatorID:    user.ID,
+		ContentID:    fmt.Sprintf("%s%d", apiv1.MemoNamePrefix, memo.ID),
+		ReactionType: storepb.ReactionType_HEART,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoOrganizer(ctx, &store.MemoOrganizer{
+		MemoID: memo.ID,
+		UserID: user.ID,
+		Pinned: true,
+	})
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, apiv1.WriteMemoArchive(ctx, ts, user, buf))
+	reader, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	manifestFile, err := reader.Open(apiv1.MemoArchiveManifestPath)
+	require.NoError(t, err)
+	manifest := &apiv1.MemoArchiveManifest{}
+	require.NoError(t, json.NewDecoder(manifestFile).Decode(manifest))
+	require.Equal(t, apiv1.MemoArchiveVersion, manifest.Version)
+	require.Equal(t, 2, len(manifest.Memos))
+	require.Equal(t, 2, len(manifest.Relations))
+	require.ElementsMatch(t, []int32{comment.ID, otherComment.ID}, []int32{manifest.Relations[0].MemoID, manifest.Relations[1].MemoID})
+	require.Equal(t, 1, len(manifest.Reactions))
+	require.Equal(t, "HEART", manifest.Reactions[0].ReactionType)
+	require.Equal(t, 1, len(manifest.Organizers))
+	require.True(t, manifest.Organizers[0].Pinned)
+	for _, archiveMemo := range manifest.Memos {
+		if archiveMemo.ID == memo.ID {
+			payload := &storepb.MemoPayload{}
+			require.NoError(t, protojson.Unmarshal(archiveMemo.Payload, payload))
+			require.Equal(t, []string{"tag"}, payload.Property.Tags)
+		}
+	}
+
+	require.Equal(t, 1, len(manifest.Resources))
+	require.Equal(t, "resources/test-resource/hello.txt", manifest.Resources[0].Path)
+	resourceFile, err := reader.Open(manifest.Resources[0].Path)
+	require.NoError(t, err)
+	blob, err := io.ReadAll(resourceFile)
+	require.NoError(t, err)
+	require.Equal(t, "hello world", string(blob))
+
+	// The memos are read page by page.
+	for i := 0; i < 150; i++ {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("memo %d", i),
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+	}
+	buf.Reset()
+	require.NoError(t, apiv1.WriteMemoArchive(ctx, ts, user, buf))
+	reader, err = zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	manifestFile, err = reader.Open(apiv1.MemoArchiveManifestPath)
+	require.NoError(t, err)
+	manifest = &apiv1.MemoArchiveManifest{}
+	require.NoError(t, json.NewDecoder(manifestFile).Decode(manifest))
+	require.Equal(t, 152, len(manifest.Memos))
+	uids := map[string]bool{}
+	for _, archiveMemo := range manifest.Memos {
+		uids[archiveMemo.UID] = true
+	}
+	require.Equal(t, 152, len(uids))
+}
@@ -0,0 +1,115 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoCollaboratorService(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	ts := s.Store
+	user, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	colleague, colleagueCtx := s.CreateUser(ctx, t, "colleague", store.RoleUser)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	inboxService := v1pb.NewInboxServiceClient(s.Conn)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-collaborator",
+		CreatorID:  user.ID,
+		Content:    "plan",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	memoName := fmt.Sprintf("%s%d", apiv1.MemoNamePrefix, memo.ID)
+	colleagueName := fmt.Sprintf("%s%d", apiv1.UserNamePrefix, colleague.ID)
+
+	listColleagueMemos := func() []*v1pb.Memo {
+		response, err := memoService.ListMemos(colleagueCtx, &v1pb.ListMemosRequest{})
+		require.NoError(t, err)
+		return response.Memos
+	}
+	comment := func() error {
+		_, err := memoService.CreateMemoComment(colleagueCtx, &v1pb.CreateMemoCommentRequest{
+			Name:    memoName,
+			Comment: &v1pb.CreateMemoRequest{Content: "comment", Visibility: v1pb.Visibility_PRIVATE},
+		})
+		return err
+	}
+	edit := func() error {
+		_, err := memoService.UpdateMemo(colleagueCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memoName, Content: "plan edited"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		return err
+	}
+
+	_, err = memoService.GetMemo(colleagueCtx, &v1pb.GetMemoRequest{Name: memoName})
+	require.Error(t, err)
+	require.Empty(t, listColleagueMemos())
+	require.Error(t, comment())
+
+	_, err = memoService.SetMemoCollaborator(colleagueCtx, &v1pb.SetMemoCollaboratorRequest{
+		Parent:       memoName,
+		Collaborator: &v1pb.MemoCollaborator{User: colleagueName, Permission: v1pb.MemoCollaborator_READ},
+	})
+	require.Error(t, err)
+	collaborator, err := memoService.SetMemoCollaborator(userCtx, &v1pb.SetMemoCollaboratorRequest{
+		Parent:       memoName,
+		Collaborator: &v1pb.MemoCollaborator{User: colleagueName, Permission: v1pb.MemoCollaborator_READ},
+	})
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%s/%s%d", memoName, apiv1.MemoCollaboratorNamePrefix, colleague.ID), collaborator.Name)
+	_, err = memoService.GetMemo(colleagueCtx, &v1pb.GetMemoRequest{Name: memoName})
+	require.NoError(t, err)
+	require.Len(t, listColleagueMemos(), 1)
+	require.Error(t, comment())
+	require.Error(t, edit())
+
+	// The colleague is notified of the share and of each change of permission.
+	_, err = memoService.SetMemoCollaborator(userCtx, &v1pb.SetMemoCollaboratorRequest{
+		Parent:       memoName,
+		Collaborator: &v1pb.MemoCollaborator{User: colleagueName, Permission: v1pb.MemoCollaborator_COMMENT},
+	})
+	require.NoError(t, err)
+	require.NoError(t, comment())
+	require.Error(t, edit())
+	_, err = memoService.SetMemoCollaborator(userCtx, &v1pb.SetMemoCollaboratorRequest{
+		Parent:       memoName,
+		Collaborator: &v1pb.MemoCollaborator{User: colleagueName, Permission: v1pb.MemoCollaborator_EDIT},
+	})
+	require.NoError(t, err)
+	require.NoError(t, edit())
+	_, err = memoService.UpdateMemo(colleagueCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memoName, Visibility: v1pb.Visibility_PUBLIC},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.Error(t, err)
+	_, err = memoService.SetMemoCollaborator(userCtx, &v1pb.SetMemoCollaboratorRequest{
+		Parent:       memoName,
+		Collaborator: &v1pb.MemoCollaborator{User: colleagueName, Permission: v1pb.MemoCollaborator_EDIT},
+	})
+	require.NoError(t, err)
+	inboxes, err := inboxService.ListInboxes(colleagueCtx, &v1pb.ListInboxesRequest{})
+	require.NoError(t, err)
+	require.Len(t, inboxes.Inboxes, 3)
+	require.Equal(t, v1pb.Inbox_MEMO_SHARE, inboxes.Inboxes[0].Type)
+
+	response, err := memoService.ListMemoCollaborators(userCtx, &v1pb.ListMemoCollaboratorsRequest{Parent: memoName})
+	require.NoError(t, err)
+	require.Len(t, response.Collaborators, 1)
+	require.Equal(t, v1pb.MemoCollaborator_EDIT, response.Collaborators[0].Permission)
+
+	_, err = memoService.RemoveMemoCollaborator(userCtx, &v1pb.RemoveMemoCollaboratorRequest{Name: collaborator.Name})
+	require.NoError(t, err)
+	_, err = memoService.GetMemo(colleagueCtx, &v1pb.GetMemoRequest{Name: memoName})
+	require.Error(t, err)
+	require.Empty(t, listColleagueMemos())
+}
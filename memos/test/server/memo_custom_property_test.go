@@ -0,0 +1,203 @@
+package testserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoCustomProperties(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+
+	_, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content: "invalid",
+		CustomProperties: map[string]*v1pb.MemoCustomProperty{
+			"due": {Type: v1pb.MemoCustomProperty_DATE, Value: &v1pb.MemoCustomProperty_StringValue{StringValue: "tomorrow"}},
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content: "invalid",
+		CustomProperties: map[string]*v1pb.MemoCustomProperty{
+			"the status": {Value: &v1pb.MemoCustomProperty_StringValue{StringValue: "open"}},
+		},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The front matter of the content takes precedence over the properties in the request.
+	openMemo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content: "---\nstatus: open\npriority: 3\ndue: 2024-06-01\ndone: false\ntags: [a, b]\n---\nFix the login",
+		CustomProperties: map[string]*v1pb.MemoCustomProperty{
+			"status":   {Type: v1pb.MemoCustomProperty_STRING, Value: &v1pb.MemoCustomProperty_StringValue{StringValue: "closed"}},
+			"customer": {Value: &v1pb.MemoCustomProperty_StringValue{StringValue: "ACME"}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, openMemo.CustomProperties, 5)
+	require.Equal(t, v1pb.MemoCustomProperty_STRING, openMemo.CustomProperties["status"].Type)
+	require.Equal(t, "open", openMemo.CustomProperties["status"].GetStringValue())
+	require.Equal(t, float64(3), openMemo.CustomProperties["priority"].GetNumberValue())
+	require.Equal(t, v1pb.MemoCustomProperty_DATE, openMemo.CustomProperties["due"].Type)
+	require.Equal(t, v1pb.MemoCustomProperty_BOOL, openMemo.CustomProperties["done"].Type)
+	require.Equal(t, v1pb.MemoCustomProperty_STRING, openMemo.CustomProperties["customer"].Type)
+
+	closedMemo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Content: "Ship the release"})
+	require.NoError(t, err)
+	require.Empty(t, closedMemo.CustomProperties)
+	closedMemo, err = memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name: closedMemo.Name,
+			CustomProperties: map[string]*v1pb.MemoCustomProperty{
+				"status":   {Value: &v1pb.MemoCustomProperty_StringValue{StringValue: "closed"}},
+				"priority": {Value: &v1pb.MemoCustomProperty_NumberValue{NumberValue: 1.5}},
+				"done":     {Value: &v1pb.MemoCustomProperty_BoolValue{BoolValue: true}},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"custom_properties"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, closedMemo.CustomProperties, 3)
+
+	// A single property is set or removed by its own path.
+	openMemo, err = memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo: &v1pb.Memo{
+			Name: openMemo.Name,
+			CustomProperties: map[string]*v1pb.MemoCustomProperty{
+				"priority": {Value: &v1pb.MemoCustomProperty_NumberValue{NumberValue: 5}},
+			},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"custom_properties.priority", "custom_properties.customer"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, openMemo.CustomProperties, 4)
+	require.Equal(t, float64(5), openMemo.CustomProperties["priority"].GetNumberValue())
+	require.Nil(t, openMemo.CustomProperties["customer"])
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{filter: `property.status == "open" && property.priority > 2`, want: []string{openMemo.Name}},
+		{filter: `property.status in ["open", "closed"]`, want: []string{closedMemo.Name, openMemo.Name}},
+		{filter: `property.status != "open"`, want: []string{closedMemo.Name}},
+		{filter: `property.priority <= 1.5`, want: []string{closedMemo.Name}},
+		{filter: `property.done && property.priority > 1`, want: []string{closedMemo.Name}},
+		{filter: `property.done == false`, want: []string{openMemo.Name}},
+		{filter: `property.due < "2024-07-01"`, want: []string{openMemo.Name}},
+		// Values are only compared with the values of the same type.
+		{filter: `property.status == 1`, want: []string{}},
+		{filter: `property.missing == "open"`, want: []string{}},
+	}
+	for _, test := range tests {
+		response, err := memoService.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: test.filter})
+		require.NoError(t, err, test.filter)
+		require.Equal(t, test.want, getMemoNames(response.Memos), test.filter)
+	}
+
+	// The properties extracted from the front matter are removed along with their keys, unlike the ones set by request.
+	openMemo, err = memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: openMemo.Name, Content: "---\nstatus: closed\n---\nFix the login"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, openMemo.CustomProperties, 2)
+	require.Equal(t, "closed", openMemo.CustomProperties["status"].GetStringValue())
+	require.Equal(t, float64(5), openMemo.CustomProperties["priority"].GetNumberValue())
+	openMemo, err = memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: openMemo.Name, Content: "Fix the login"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	require.Len(t, openMemo.CustomProperties, 1)
+	require.Equal(t, float64(5), openMemo.CustomProperties["priority"].GetNumberValue())
+}
+
+func TestMemoCustomPropertiesFromFrontMatter(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	getCustomProperties := func(content string) map[string]*v1pb.MemoCustomProperty {
+		memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Content: content})
+		require.NoError(t, err)
+		return memo.CustomProperties
+	}
+
+	properties := getCustomProperties("---\ntitle: \"2024\"\ncount: 1_000\nstarted: 2024-06-01T10:00:00Z\nnested:\n  a: 1\n---\n")
+	require.Len(t, properties, 3)
+	require.Equal(t, v1pb.MemoCustomProperty_STRING, properties["title"].Type)
+	require.Equal(t, float64(1000), properties["count"].GetNumberValue())
+	require.Equal(t, v1pb.MemoCustomProperty_STRING, properties["started"].Type)
+
+	// A leading thematic break is not front matter.
+	require.Empty(t, getCustomProperties("---\nJust some text: with a colon\nand more\n---\n"))
+	require.Empty(t, getCustomProperties("No front matter\n---\nstatus: open\n---\n"))
+}
+
+func TestMemoEnumCustomProperties(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	statusProperty := func(value string, allowedValues ...string) map[string]*v1pb.MemoCustomProperty {
+		return map[string]*v1pb.MemoCustomProperty{
+			"status": {Type: v1pb.MemoCustomProperty_ENUM, AllowedValues: allowedValues, Value: &v1pb.MemoCustomProperty_StringValue{StringValue: value}},
+		}
+	}
+
+	for _, customProperties := range []map[string]*v1pb.MemoCustomProperty{
+		statusProperty("open"),
+		statusProperty("blocked", "open", "closed"),
+		{"status": {Type: v1pb.MemoCustomProperty_ENUM, AllowedValues: []string{"1"}, Value: &v1pb.MemoCustomProperty_NumberValue{NumberValue: 1}}},
+		{"status": {Type: v1pb.MemoCustomProperty_STRING, AllowedValues: []string{"open"}, Value: &v1pb.MemoCustomProperty_StringValue{StringValue: "open"}}},
+	} {
+		_, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Content: "invalid", CustomProperties: customProperties})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), customProperties)
+	}
+	memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:          "Fix the login",
+		CustomProperties: statusProperty("open", "open", "closed"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.MemoCustomProperty_ENUM, memo.CustomProperties["status"].Type)
+	require.Equal(t, []string{"open", "closed"}, memo.CustomProperties["status"].AllowedValues)
+	response, err := memoService.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: `property.status == "open"`})
+	require.NoError(t, err)
+	require.Equal(t, []string{memo.Name}, getMemoNames(response.Memos))
+
+	_, err = memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, CustomProperties: statusProperty("blocked", "open", "closed")},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"custom_properties.status"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// A string in the front matter keeps the type of the enum property it replaces.
+	updateContent := func(content string) (*v1pb.Memo, error) {
+		return memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: content},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+	}
+	_, err = updateContent("---\nstatus: blocked\n---\nFix the login")
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	memo, err = updateContent("---\nstatus: closed\n---\nFix the login")
+	require.NoError(t, err)
+	require.Equal(t, v1pb.MemoCustomProperty_ENUM, memo.CustomProperties["status"].Type)
+	require.Equal(t, "closed", memo.CustomProperties["status"].GetStringValue())
+	require.Equal(t, []string{"open", "closed"}, memo.CustomProperties["status"].AllowedValues)
+	_, err = memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:          "---\nstatus: blocked\n---\nShip the release",
+		CustomProperties: statusProperty("open", "open", "closed"),
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,192 @@
+package testserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoEtag(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+
+	memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:    "draft",
+		Visibility: v1pb.Visibility_PRIVATE,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, memo.Etag)
+
+	updateContent := func(etag, content string) (*v1pb.Memo, error) {
+		return memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: content, Etag: etag},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+	}
+	updated, err := updateContent(memo.Etag, "edited on the laptop")
+	require.NoError(t, err)
+	require.NotEqual(t, memo.Etag, updated.Etag)
+
+	// The phone still has the first version, so its update is rejected along with the current version.
+	_, err = updateContent(memo.Etag, "edited on the phone")
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+	current, ok := details[0].(*v1pb.Memo)
+	require.True(t, ok)
+	require.Equal(t, "edited on the laptop", current.Content)
+	require.Equal(t, updated.Etag, current.Etag)
+	_, err = memoService.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name, Etag: memo.Etag})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// Updates without etags are last-write-wins.
+	_, err = updateContent("", "edited anywhere")
+	require.NoError(t, err)
+	latest, err := memoService.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	_, err = memoService.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name, Etag: latest.Etag})
+	require.NoError(t, err)
+
+	// Etags change with each update even if the content does not.
+	memo, err = memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:    "same",
+		Visibility: v1pb.Visibility_PRIVATE,
+	})
+	require.NoError(t, err)
+	updated, err = updateContent(memo.Etag, "same")
+	require.NoError(t, err)
+	require.NotEqual(t, memo.Etag, updated.Etag)
+}
+
+func TestMemoEtagConcurrentUpdates(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+
+	memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:    "draft",
+		Visibility: v1pb.Visibility_PRIVATE,
+	})
+	require.NoError(t, err)
+
+	// Both devices update the same version at once, so only one of them may win.
+	contents := []string{"edited on the laptop", "edited on the phone"}
+	errs := make([]error, len(contents))
+	var wg sync.WaitGroup
+	for i, content := range contents {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+				Memo:       &v1pb.Memo{Name: memo.Name, Content: content, Etag: memo.Etag},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+			})
+		}()
+	}
+	wg.Wait()
+
+	winner := -1
+	for i, err := range errs {
+		if err == nil {
+			require.Equal(t, -1, winner, "both updates succeeded")
+			winner = i
+			continue
+		}
+		require.Equal(t, codes.FailedPrecondition, status.Code(err), err)
+	}
+	require.NotEqual(t, -1, winner, "no update succeeded")
+	latest, err := memoService.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, contents[winner], latest.Content)
+}
+
+func TestMemoEtagOfOtherWrites(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:    "- [ ] call #mom",
+		Visibility: v1pb.Visibility_PRIVATE,
+	})
+	require.NoError(t, err)
+	// requireChanged requires the memo to have been changed since the etag, and returns its current etag.
+	requireChanged := func(etag string) string {
+		_, err := memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Visibility: v1pb.Visibility_PUBLIC, Etag: etag},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+		})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		current, err := memoService.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		require.NotEqual(t, etag, current.Etag)
+		return current.Etag
+	}
+
+	// All the writes of the memo change its etag, even within the same second.
+	_, err = memoService.SetTaskCompleted(userCtx, &v1pb.SetTaskCompletedRequest{Name: memo.Name + "/tasks/0", Completed: true})
+	require.NoError(t, err)
+	etag := requireChanged(memo.Etag)
+	_, err = memoService.RenameMemoTag(userCtx, &v1pb.RenameMemoTagRequest{Parent: memo.Name, OldTag: "mom", NewTag: "family"})
+	require.NoError(t, err)
+	etag = requireChanged(etag)
+	_, err = memoService.DeleteMemoTag(userCtx, &v1pb.DeleteMemoTagRequest{Parent: memo.Name, Tag: "family"})
+	require.NoError(t, err)
+	etag = requireChanged(etag)
+	revisions, err := memoService.ListMemoRevisions(userCtx, &v1pb.ListMemoRevisionsRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.NotEmpty(t, revisions.Revisions)
+	_, err = memoService.RestoreMemoRevision(userCtx, &v1pb.RestoreMemoRevisionRequest{Name: revisions.Revisions[0].Name})
+	require.NoError(t, err)
+	requireChanged(etag)
+}
+
+func TestMemoDisplayTimeWithUpdateTime(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	_, err := s.Store.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_MEMO_RELATED,
+		Value: &storepb.WorkspaceSetting_MemoRelatedSetting{
+			MemoRelatedSetting: &storepb.WorkspaceMemoRelatedSetting{
+				DisplayWithUpdateTime: true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:    "draft",
+		Visibility: v1pb.Visibility_PRIVATE,
+	})
+	require.NoError(t, err)
+	updateDisplayTime := func(displayTime time.Time) (*v1pb.Memo, error) {
+		return memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, DisplayTime: timestamppb.New(displayTime), Etag: memo.Etag},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_ts"}},
+		})
+	}
+
+	// The display time is the update time, which never moves backward.
+	_, err = updateDisplayTime(memo.DisplayTime.AsTime().Add(-time.Hour))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	updated, err := updateDisplayTime(memo.DisplayTime.AsTime().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, memo.DisplayTime.AsTime().Add(time.Hour), updated.DisplayTime.AsTime())
+	_, err = updateDisplayTime(memo.DisplayTime.AsTime().Add(2 * time.Hour))
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
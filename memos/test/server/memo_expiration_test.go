@@ -0,0 +1,92 @@
+package testserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestExpiringMemo(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	ts := s.Store
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+
+	_, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:          "secret",
+		Visibility:       v1pb.Visibility_PRIVATE,
+		ExpirationPolicy: v1pb.MemoExpirationPolicy_DELETE,
+	})
+	require.Error(t, err)
+	expireTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	archivedMemo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:    "status",
+		Visibility: v1pb.Visibility_PRIVATE,
+		ExpireTime: timestamppb.New(expireTime),
+	})
+	require.NoError(t, err)
+	require.Equal(t, expireTime.Unix(), archivedMemo.ExpireTime.AsTime().Unix())
+	require.Equal(t, v1pb.MemoExpirationPolicy_ARCHIVE, archivedMemo.ExpirationPolicy)
+	deletedMemo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:          "secret",
+		Visibility:       v1pb.Visibility_PRIVATE,
+		ExpireTime:       timestamppb.New(expireTime),
+		ExpirationPolicy: v1pb.MemoExpirationPolicy_DELETE,
+	})
+	require.NoError(t, err)
+	_, err = memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:    "note",
+		Visibility: v1pb.Visibility_PRIVATE,
+	})
+	require.NoError(t, err)
+	response, err := memoService.ListMemos(userCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 3)
+	share, err := memoService.CreateMemoShare(userCtx, &v1pb.CreateMemoShareRequest{Parent: deletedMemo.Name, Share: &v1pb.MemoShare{}})
+	require.NoError(t, err)
+
+	// Expired memos are hidden from listings before being swept.
+	expiredTime := time.Now().Add(-time.Minute)
+	for _, memo := range []*v1pb.Memo{archivedMemo, deletedMemo} {
+		_, err := memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, ExpireTime: timestamppb.New(expiredTime), ExpirationPolicy: memo.ExpirationPolicy},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"expire_time", "expiration_policy"}},
+		})
+		require.NoError(t, err)
+	}
+	response, err = memoService.ListMemos(userCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 1)
+	require.Equal(t, "note", response.Memos[0].Content)
+	// Nor can they be read by their names, even with a share link.
+	_, err = memoService.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: deletedMemo.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = memoService.GetMemo(ctx, &v1pb.GetMemoRequest{Name: deletedMemo.Name, ShareToken: share.Token})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = memoService.ListMemoBacklinks(userCtx, &v1pb.ListMemoBacklinksRequest{Name: deletedMemo.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	require.NoError(t, s.Service.ExpireMemos(ctx, time.Now()))
+	archived, err := memoService.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: archivedMemo.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.RowStatus_ARCHIVED, archived.RowStatus)
+	require.Nil(t, archived.ExpireTime)
+	_, err = memoService.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: deletedMemo.Name})
+	require.Error(t, err)
+	deletedMemoID, err := apiv1.ExtractMemoIDFromName(deletedMemo.Name)
+	require.NoError(t, err)
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{ID: &deletedMemoID, IncludeTrashed: true})
+	require.NoError(t, err)
+	require.Nil(t, memo)
+}
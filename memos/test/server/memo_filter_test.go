@@ -0,0 +1,113 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestListMemosWithFilter(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	createMemo := func(content string, visibility v1pb.Visibility, displayTs int64) *v1pb.Memo {
+		memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Content: content, Visibility: visibility})
+		require.NoError(t, err)
+		memo, err = memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, DisplayTime: timestamppb.New(time.Unix(displayTs, 0))},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_ts"}},
+		})
+		require.NoError(t, err)
+		return memo
+	}
+	first := createMemo("#a first", v1pb.Visibility_PUBLIC, 100)
+	createMemo("#a/sub second", v1pb.Visibility_PRIVATE, 150)
+	third := createMemo("#beta third\n\n```go\nfmt.Println()\n```", v1pb.Visibility_PROTECTED, 200)
+	createMemo("plain fourth", v1pb.Visibility_PUBLIC, 250)
+	collectionService := v1pb.NewCollectionServiceClient(s.Conn)
+	collection, err := collectionService.CreateCollection(userCtx, &v1pb.CreateCollectionRequest{Collection: &v1pb.Collection{Title: "Picks"}})
+	require.NoError(t, err)
+	_, err = collectionService.SetCollectionMemos(userCtx, &v1pb.SetCollectionMemosRequest{Name: collection.Name, Memos: []string{first.Name, third.Name}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{
+			filter: `tag == "a"`,
+			want:   []string{"#a first", "#a/sub second"},
+		},
+		{
+			filter: `tag == "a" || tag.startsWith("b")`,
+			want:   []string{"#a first", "#a/sub second", "#beta third\n\n```go\nfmt.Println()\n```"},
+		},
+		{
+			filter: `(tag == "a" || tag.startsWith("b")) && !has_code`,
+			want:   []string{"#a first", "#a/sub second"},
+		},
+		{
+			filter: `display_time_after > 100 && display_time_before == 250`,
+			want:   []string{"#a/sub second", "#beta third\n\n```go\nfmt.Println()\n```"},
+		},
+		{
+			filter: `visibility in ["PUBLIC", "PROTECTED"] && !content.contains("first")`,
+			want:   []string{"#beta third\n\n```go\nfmt.Println()\n```", "plain fourth"},
+		},
+		{
+			filter: `tag != "a" && content.startsWith("plain")`,
+			want:   []string{"plain fourth"},
+		},
+		{
+			filter: fmt.Sprintf(`collection == %q`, collection.Name),
+			want:   []string{"#a first", "#beta third\n\n```go\nfmt.Println()\n```"},
+		},
+		{
+			filter: fmt.Sprintf(`collection == %q && tag == "a"`, collection.Name),
+			want:   []string{"#a first"},
+		},
+	}
+	for _, test := range tests {
+		response, err := memoService.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: test.filter})
+		require.NoError(t, err, test.filter)
+		contents := []string{}
+		for _, memo := range response.Memos {
+			contents = append(contents, memo.Content)
+		}
+		require.ElementsMatch(t, test.want, contents, test.filter)
+	}
+}
+
+func TestListMemosWithUnsupportedFilter(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	filters := []string{
+		`tag == "a" || random == true`,
+		`!order_by_pinned`,
+		`content.endsWith("a")`,
+		`tag > "a"`,
+		`limit`,
+		`collection == "memos/1"`,
+		`collection != "collections/1"`,
+		`property.done > true`,
+		`property.status == content`,
+		`property.status.contains("open")`,
+	}
+	for _, filter := range filters {
+		_, err := memoService.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: filter})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), filter)
+	}
+}
@@ -0,0 +1,134 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestGetMemoGraph(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	ts := s.Store
+	user, _ := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	// memo-a -> memo-b -> memo-c, where memo-c is not visible to the anonymous caller.
+	memos := []*store.Memo{}
+	for _, memoCreate := range []*store.Memo{
+		{UID: "memo-a", Content: "#project/alpha a", Visibility: store.Public, Payload: &storepb.MemoPayload{
+			Property: &storepb.MemoPayload_Property{Tags: []string{"project/alpha"}},
+		}},
+		{UID: "memo-b", Content: "b", Visibility: store.Public},
+		{UID: "memo-c", Content: "c", Visibility: store.Protected},
+	} {
+		memoCreate.CreatorID = user.ID
+		memo, err := ts.CreateMemo(ctx, memoCreate)
+		require.NoError(t, err)
+		memos = append(memos, memo)
+	}
+	for _, relation := range [][2]*store.Memo{{memos[0], memos[1]}, {memos[1], memos[2]}} {
+		_, err := ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        relation[0].ID,
+			RelatedMemoID: relation[1].ID,
+			Type:          store.MemoRelationReference,
+		})
+		require.NoError(t, err)
+	}
+	memoName := func(memo *store.Memo) string {
+		return fmt.Sprintf("%s%d", apiv1.MemoNamePrefix, memo.ID)
+	}
+
+	for _, request := range []*v1pb.GetMemoGraphRequest{
+		{Name: memoName(memos[0]), Depth: 2},
+		{Tag: "project", Depth: 2},
+	} {
+		graph, err := memoService.GetMemoGraph(ctx, request)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(graph.Nodes))
+		require.Equal(t, memoName(memos[0]), graph.Nodes[0].Memo.Name)
+		require.Equal(t, int32(0), graph.Nodes[0].Depth)
+		require.Equal(t, memoName(memos[1]), graph.Nodes[1].Memo.Name)
+		require.Equal(t, int32(1), graph.Nodes[1].Depth)
+		require.Equal(t, 1, len(graph.Edges))
+		require.Equal(t, memoName(memos[0]), graph.Edges[0].Memo)
+		require.Equal(t, memoName(memos[1]), graph.Edges[0].RelatedMemo)
+	}
+
+	graph, err := memoService.GetMemoGraph(ctx, &v1pb.GetMemoGraphRequest{
+		Name:  memoName(memos[0]),
+		Types: []v1pb.MemoRelation_Type{v1pb.MemoRelation_COMMENT},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(graph.Nodes))
+	require.Equal(t, 0, len(graph.Edges))
+
+	_, err = memoService.GetMemoGraph(ctx, &v1pb.GetMemoGraphRequest{Name: memoName(memos[2])})
+	require.Error(t, err)
+	_, err = memoService.GetMemoGraph(ctx, &v1pb.GetMemoGraphRequest{Name: memoName(memos[0]), Depth: apiv1.MaxMemoGraphDepth + 1})
+	require.Error(t, err)
+}
+
+func TestMemoGraphVisibility(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	ts := s.Store
+	user, _ := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	viewer, viewerCtx := s.CreateUser(ctx, t, "viewer", store.RoleUser)
+
+	// memo-a -> memo-b -> memo-d and memo-a -> memo-c, where memo-b is shared with the viewer and memo-c has expired.
+	memos := map[string]*store.Memo{}
+	for _, memoCreate := range []*store.Memo{
+		{UID: "memo-a", Visibility: store.Public},
+		{UID: "memo-b", Visibility: store.Private},
+		{UID: "memo-c", Visibility: store.Public, Payload: &storepb.MemoPayload{
+			Expiration: &storepb.MemoPayload_Expiration{ExpireTs: time.Now().Add(-time.Minute).Unix()},
+		}},
+		{UID: "memo-d", Visibility: store.Public},
+	} {
+		memoCreate.CreatorID = user.ID
+		memoCreate.Content = memoCreate.UID
+		memo, err := ts.CreateMemo(ctx, memoCreate)
+		require.NoError(t, err)
+		memos[memo.UID] = memo
+	}
+	for _, relation := range [][2]string{{"memo-a", "memo-b"}, {"memo-b", "memo-d"}, {"memo-a", "memo-c"}} {
+		_, err := ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        memos[relation[0]].ID,
+			RelatedMemoID: memos[relation[1]].ID,
+			Type:          store.MemoRelationReference,
+		})
+		require.NoError(t, err)
+	}
+	_, err := ts.UpsertMemoShare(ctx, &store.MemoShare{
+		MemoID:     memos["memo-b"].ID,
+		CreatorID:  user.ID,
+		UserID:     viewer.ID,
+		Permission: store.MemoSharePermissionRead,
+	})
+	require.NoError(t, err)
+	listNodeContents := func(ctx context.Context, root string) []string {
+		graph, err := memoService.GetMemoGraph(ctx, &v1pb.GetMemoGraphRequest{
+			Name:  fmt.Sprintf("%s%d", apiv1.MemoNamePrefix, memos[root].ID),
+			Depth: 2,
+		})
+		require.NoError(t, err)
+		contents := []string{}
+		for _, node := range graph.Nodes {
+			contents = append(contents, node.Memo.Content)
+		}
+		return contents
+	}
+
+	require.Equal(t, []string{"memo-a"}, listNodeContents(ctx, "memo-a"))
+	require.Equal(t, []string{"memo-a", "memo-b", "memo-d"}, listNodeContents(viewerCtx, "memo-a"))
+	require.Equal(t, []string{"memo-b", "memo-a", "memo-d"}, listNodeContents(viewerCtx, "memo-b"))
+}
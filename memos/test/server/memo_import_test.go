@@ -0,0 +1,140 @@
+package testserver
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestImportMemos(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	ts := s.Store
+	user, _ := s.CreateUser(ctx, t, "test", store.RoleHost)
+
+	fsys := fstest.MapFS{
+		// The layout written by ExportMemos.
+		"2024-01-02T03:04:05Z-PUBLIC.md": {Data: []byte("exported memo #work\n\n- [ ] see [[memos/hello-memo]]")},
+		"notes/hello.md": {Data: []byte(`---
+uid: hello-memo
+created: 2023-05-06T07:08:09Z
+visibility: protected
+tags: [idea, work]
+pinned: true
+resources: [files/a.txt]
+---
+Hello ![image](../images/b%20c.png)
+`)},
+		"notes/files/a.txt":   {Data: []byte("a")},
+		"images/b c.png":      {Data: []byte("b")},
+		"notes/world.md":      {Data: []byte("---\nuid: hello-memo\n---\nduplicate")},
+		"notes/invalid.md":    {Data: []byte("---\nvisibility: secret\n---\ninvalid")},
+		"__MACOSX/ignored.md": {Data: []byte("ignored")},
+	}
+
+	response, err := apiv1.ImportMemosFromFS(ctx, ts, user, fsys, true)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), response.ImportedCount)
+	require.Equal(t, int32(2), response.SkippedCount)
+	require.Equal(t, 4, len(response.Results))
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoList))
+
+	response, err = apiv1.ImportMemosFromFS(ctx, ts, user, fsys, false)
+	require.NoError(t, err)
+	require.Equal(t, int32(2), response.ImportedCount)
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{UID: &[]string{"hello-memo"}[0]})
+	require.NoError(t, err)
+	require.NotNil(t, memo)
+	require.Equal(t, store.Protected, memo.Visibility)
+	require.Equal(t, time.Date(2023, 5, 6, 7, 8, 9, 0, time.UTC).Unix(), memo.CreatedTs)
+	require.Equal(t, []string{"idea", "work"}, memo.Payload.Property.Tags)
+	require.Equal(t, "Hello ![image](../images/b%20c.png)\n\n#idea #work", memo.Content)
+	resources, err := ts.ListResources(ctx, &store.FindResource{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resources))
+	organizer, err := ts.GetMemoOrganizer(ctx, &store.FindMemoOrganizer{MemoID: memo.ID, UserID: user.ID})
+	require.NoError(t, err)
+	require.True(t, organizer.Pinned)
+
+	exportedMemos, err := ts.ListMemos(ctx, &store.FindMemo{VisibilityList: []store.Visibility{store.Public}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(exportedMemos))
+	require.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC).Unix(), exportedMemos[0].CreatedTs)
+	// The exported memo links to the memo after it in the archive.
+	relations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &exportedMemos[0].ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(relations))
+	require.Equal(t, memo.ID, relations[0].RelatedMemoID)
+	tasks, err := ts.ListMemoTasks(ctx, &store.FindMemoTask{MemoID: &exportedMemos[0].ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tasks))
+
+	// Memos are de-duplicated by uid.
+	response, err = apiv1.ImportMemosFromFS(ctx, ts, user, fsys, false)
+	require.NoError(t, err)
+	for _, result := range response.Results {
+		if result.Uid == "hello-memo" {
+			require.Equal(t, v1pb.ImportMemoResult_SKIP, result.Action)
+		}
+	}
+}
+
+// unreadableFS fails to read the files at the given path, while they can still be found.
+type unreadableFS struct {
+	fstest.MapFS
+	unreadable string
+}
+
+func (f unreadableFS) ReadFile(name string) ([]byte, error) {
+	if name == f.unreadable {
+		return nil, &fs.PathError{Op: "read", Path: name, Err: fs.ErrPermission}
+	}
+	return f.MapFS.ReadFile(name)
+}
+
+func TestImportMemoCleansUpResourceFiles(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	ts := s.Store
+	user, _ := s.CreateUser(ctx, t, "test", store.RoleHost)
+	_, err := ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_STORAGE,
+		Value: &storepb.WorkspaceSetting_StorageSetting{StorageSetting: &storepb.WorkspaceStorageSetting{
+			StorageType: storepb.WorkspaceStorageSetting_LOCAL,
+		}},
+	})
+	require.NoError(t, err)
+
+	// The memo can not be imported as its second file can not be read, so the saved file of the first one is deleted.
+	fsys := unreadableFS{
+		MapFS: fstest.MapFS{
+			"memo.md": {Data: []byte("---\nuid: imported\nresources: [a.txt, b.txt]\n---\nimported")},
+			"a.txt":   {Data: []byte("a")},
+			"b.txt":   {Data: []byte("b")},
+		},
+		unreadable: "b.txt",
+	}
+	_, err = apiv1.ImportMemosFromFS(ctx, ts, user, fsys, false)
+	require.Error(t, err)
+	files, err := filepath.Glob(filepath.Join(ts.Profile.Data, "assets", "*"))
+	require.NoError(t, err)
+	require.Empty(t, files)
+	_, err = os.Stat(filepath.Join(ts.Profile.Data, "assets"))
+	require.NoError(t, err)
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{UID: &[]string{"imported"}[0]})
+	require.NoError(t, err)
+	require.Nil(t, memo)
+}
@@ -0,0 +1,146 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	// sqlite driver.
+	_ "modernc.org/sqlite"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
+	"github.com/usememos/memos/test"
+)
+
+// countingDriver counts the queries issued by the store while listing memos.
+type countingDriver struct {
+	store.Driver
+	queries atomic.Int64
+}
+
+func (d *countingDriver) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
+	d.queries.Add(1)
+	return d.Driver.ListMemos(ctx, find)
+}
+
+func (d *countingDriver) ListResources(ctx context.Context, find *store.FindResource) ([]*store.Resource, error) {
+	d.queries.Add(1)
+	return d.Driver.ListResources(ctx, find)
+}
+
+func (d *countingDriver) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation) ([]*store.MemoRelation, error) {
+	d.queries.Add(1)
+	return d.Driver.ListMemoRelations(ctx, find)
+}
+
+func (d *countingDriver) ListReactions(ctx context.Context, find *store.FindReaction) ([]*store.Reaction, error) {
+	d.queries.Add(1)
+	return d.Driver.ListReactions(ctx, find)
+}
+
+func (d *countingDriver) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User, error) {
+	d.queries.Add(1)
+	return d.Driver.ListUsers(ctx, find)
+}
+
+func (d *countingDriver) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspaceSetting) ([]*store.WorkspaceSetting, error) {
+	d.queries.Add(1)
+	return d.Driver.ListWorkspaceSettings(ctx, find)
+}
+
+func newCountingTestingService(ctx context.Context, tb testing.TB) (*apiv1.APIV1Service, *countingDriver) {
+	profile := test.GetTestingProfile(tb)
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(tb, err)
+	require.NoError(tb, dbDriver.Migrate(ctx))
+	driver := &countingDriver{Driver: dbDriver}
+	tb.Cleanup(func() {
+		dbDriver.Close()
+	})
+	return apiv1.NewAPIV1Service("test-secret", profile, store.New(driver, profile), grpc.NewServer()), driver
+}
+
+func createTestingMemos(ctx context.Context, tb testing.TB, ts *store.Store, count int) {
+	user, err := ts.CreateUser(ctx, &store.User{
+		Username: "test",
+		Role:     store.RoleHost,
+		Email:    "test@test.com",
+	})
+	require.NoError(tb, err)
+	for i := 0; i < count; i++ {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("test-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("memo %d", i),
+			Visibility: store.Public,
+		})
+		require.NoError(tb, err)
+		_, err = ts.CreateResource(ctx, &store.Resource{
+			UID:       fmt.Sprintf("test-resource-%d", i),
+			CreatorID: user.ID,
+			Filename:  "test.txt",
+			Blob:      []byte("test"),
+			Type:      "text/plain",
+			Size:      4,
+			MemoID:    &memo.ID,
+		})
+		require.NoError(tb, err)
+		_, err = ts.UpsertReaction(ctx, &store.Reaction{
+			CreatorID:    user.ID,
+			ContentID:    fmt.Sprintf("%s%d", apiv1.MemoNamePrefix, memo.ID),
+			ReactionType: storepb.ReactionType_HEART,
+		})
+		require.NoError(tb, err)
+	}
+}
+
+func TestListMemosQueryCount(t *testing.T) {
+	ctx := context.Background()
+	service, driver := newCountingTestingService(ctx, t)
+	createTestingMemos(ctx, t, service.Store, 20)
+
+	// Warm up the workspace setting cache.
+	_, err := service.ListMemos(ctx, &v1pb.ListMemosRequest{Filter: `visibilities == ["PUBLIC"]`})
+	require.NoError(t, err)
+	queryCounts := []int64{}
+	for _, pageSize := range []int32{5, 20} {
+		driver.queries.Store(0)
+		response, err := service.ListMemos(ctx, &v1pb.ListMemosRequest{PageSize: pageSize, Filter: `visibilities == ["PUBLIC"]`})
+		require.NoError(t, err)
+		require.Equal(t, int(pageSize), len(response.Memos))
+		for _, memo := range response.Memos {
+			require.Equal(t, 1, len(memo.Resources))
+			require.Equal(t, 1, len(memo.Reactions))
+		}
+		queryCounts = append(queryCounts, driver.queries.Load())
+	}
+	// The number of queries does not grow with the page size.
+	require.Equal(t, queryCounts[0], queryCounts[1])
+}
+
+func BenchmarkListMemos(b *testing.B) {
+	ctx := context.Background()
+	service, driver := newCountingTestingService(ctx, b)
+	createTestingMemos(ctx, b, service.Store, 100)
+
+	for _, pageSize := range []int32{10, 50, 100} {
+		b.Run(fmt.Sprintf("page_size=%d", pageSize), func(b *testing.B) {
+			driver.queries.Store(0)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := service.ListMemos(ctx, &v1pb.ListMemosRequest{PageSize: pageSize, Filter: `visibilities == ["PUBLIC"]`}); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(driver.queries.Load())/float64(b.N), "queries/op")
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestSyncMemoReferences(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	_, otherUserCtx := s.CreateUser(ctx, t, "other", store.RoleUser)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	createMemo := func(ctx context.Context, content string, visibility v1pb.Visibility) *v1pb.Memo {
+		memo, err := memoService.CreateMemo(ctx, &v1pb.CreateMemoRequest{Content: content, Visibility: visibility})
+		require.NoError(t, err)
+		return memo
+	}
+	updateContent := func(memo *v1pb.Memo, content string) {
+		_, err := memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: content},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+	}
+	a := createMemo(userCtx, "a", v1pb.Visibility_PRIVATE)
+	b := createMemo(userCtx, "b", v1pb.Visibility_PUBLIC)
+	c := createMemo(userCtx, "c", v1pb.Visibility_PUBLIC)
+	hidden := createMemo(otherUserCtx, "hidden", v1pb.Visibility_PRIVATE)
+	memo := createMemo(userCtx, "memo", v1pb.Visibility_PRIVATE)
+	listReferencedMemos := func() []string {
+		response, err := memoService.ListMemoRelations(userCtx, &v1pb.ListMemoRelationsRequest{Name: memo.Name})
+		require.NoError(t, err)
+		names := []string{}
+		for _, relation := range response.Relations {
+			if relation.Memo == memo.Name && relation.Type == v1pb.MemoRelation_REFERENCE {
+				names = append(names, relation.RelatedMemo)
+			}
+		}
+		return names
+	}
+
+	tests := []struct {
+		content    string
+		references []string
+	}{
+		{
+			content:    "no references",
+			references: []string{},
+		},
+		{
+			content:    fmt.Sprintf("see [[memos/%s]] and [[%s?text=hello]]", a.Uid, b.Name),
+			references: []string{a.Name, b.Name},
+		},
+		{
+			content:    fmt.Sprintf("![[memos/%s]]", a.Uid),
+			references: []string{a.Name},
+		},
+		{
+			content:    fmt.Sprintf("[a link](/m/%s) and https://memos.example.com/m/%s/ and [[resources/%s]]", a.Uid, b.Uid, c.Uid),
+			references: []string{a.Name, b.Name},
+		},
+		{
+			content:    fmt.Sprintf("- [ ] follow up on [[memos/%s]]\n\n> https://example.com/m/%s", a.Uid, a.Uid),
+			references: []string{a.Name},
+		},
+		{
+			content:    fmt.Sprintf("[other](https://example.com/mm/%s) and [nested](/m/%s/b)", a.Uid, a.Uid),
+			references: []string{},
+		},
+		{
+			// The memo itself and the private memos of other users are left out.
+			content:    fmt.Sprintf("[[%s]] /m/%s [[memos/%s]]", memo.Name, hidden.Uid, c.Uid),
+			references: []string{c.Name},
+		},
+	}
+	for _, test := range tests {
+		// The references linked from the previous content are removed along with the links.
+		updateContent(memo, test.content)
+		require.ElementsMatch(t, test.references, listReferencedMemos(), test.content)
+	}
+
+	// A relation set explicitly is kept when the links change.
+	updateContent(memo, "no references")
+	_, err := memoService.SetMemoRelations(userCtx, &v1pb.SetMemoRelationsRequest{
+		Name:      memo.Name,
+		Relations: []*v1pb.MemoRelation{{RelatedMemo: c.Name, Type: v1pb.MemoRelation_REFERENCE}},
+	})
+	require.NoError(t, err)
+	updateContent(memo, fmt.Sprintf("[link](/m/%s)", b.Uid))
+	require.ElementsMatch(t, []string{b.Name, c.Name}, listReferencedMemos())
+
+	// Setting the relations replaces the explicit ones, but keeps the ones linked from the content.
+	_, err = memoService.SetMemoRelations(userCtx, &v1pb.SetMemoRelationsRequest{
+		Name:      memo.Name,
+		Relations: []*v1pb.MemoRelation{{RelatedMemo: a.Name, Type: v1pb.MemoRelation_REFERENCE}},
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{a.Name, b.Name}, listReferencedMemos())
+}
+
+func TestListMemoBacklinks(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	otherUser, otherUserCtx := s.CreateUser(ctx, t, "other", store.RoleUser)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	createMemo := func(content string, visibility v1pb.Visibility) *v1pb.Memo {
+		memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Content: content, Visibility: visibility})
+		require.NoError(t, err)
+		return memo
+	}
+	shareMemo := func(memo *v1pb.Memo) {
+		_, err := memoService.SetMemoCollaborator(userCtx, &v1pb.SetMemoCollaboratorRequest{
+			Parent: memo.Name,
+			Collaborator: &v1pb.MemoCollaborator{
+				User:       fmt.Sprintf("users/%d", otherUser.ID),
+				Permission: v1pb.MemoCollaborator_READ,
+			},
+		})
+		require.NoError(t, err)
+	}
+	listBacklinks := func(ctx context.Context, memo *v1pb.Memo) ([]string, error) {
+		response, err := memoService.ListMemoBacklinks(ctx, &v1pb.ListMemoBacklinksRequest{Name: memo.Name})
+		if err != nil {
+			return nil, err
+		}
+		contents := []string{}
+		for _, memo := range response.Memos {
+			contents = append(contents, memo.Content)
+		}
+		return contents, nil
+	}
+	memo := createMemo("memo", v1pb.Visibility_PRIVATE)
+	link := fmt.Sprintf("[[memos/%s]]", memo.Uid)
+	createMemo("private "+link, v1pb.Visibility_PRIVATE)
+	createMemo("protected "+link, v1pb.Visibility_PROTECTED)
+	shared := createMemo("shared "+link, v1pb.Visibility_PRIVATE)
+
+	backlinks, err := listBacklinks(userCtx, memo)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"private " + link, "protected " + link, "shared " + link}, backlinks)
+	_, err = listBacklinks(otherUserCtx, memo)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The memos shared with the user are listed the same way as in ListMemos.
+	shareMemo(memo)
+	shareMemo(shared)
+	backlinks, err = listBacklinks(otherUserCtx, memo)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"protected " + link, "shared " + link}, backlinks)
+}
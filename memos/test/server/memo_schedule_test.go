@@ -0,0 +1,96 @@
+package testserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestScheduledMemo(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	ts := s.Store
+	user, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+
+	publishTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	_, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:     "draft",
+		Visibility:  v1pb.Visibility_PRIVATE,
+		PublishTime: timestamppb.New(publishTime),
+	})
+	require.Error(t, err)
+	memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:             "announcement",
+		Visibility:          v1pb.Visibility_PRIVATE,
+		PublishTime:         timestamppb.New(publishTime),
+		ScheduledVisibility: v1pb.Visibility_PUBLIC,
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PRIVATE, memo.Visibility)
+	require.Equal(t, publishTime.Unix(), memo.PublishTime.AsTime().Unix())
+	require.Equal(t, v1pb.Visibility_PUBLIC, memo.ScheduledVisibility)
+
+	// Scheduled memos are only visible to their creators, even if already public.
+	_, err = memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Visibility: v1pb.Visibility_PUBLIC},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"visibility"}},
+	})
+	require.NoError(t, err)
+	_, err = memoService.GetMemo(ctx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.Error(t, err)
+	response, err := memoService.ListMemos(ctx, &v1pb.ListMemosRequest{Filter: `creator == "users/1"`})
+	require.NoError(t, err)
+	require.Empty(t, response.Memos)
+	response, err = memoService.ListMemos(userCtx, &v1pb.ListMemosRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 1)
+
+	// Nothing is published before the publish time.
+	require.NoError(t, s.Service.PublishScheduledMemos(ctx, publishTime.Add(-time.Minute)))
+	response, err = memoService.ListMemos(ctx, &v1pb.ListMemosRequest{Filter: `creator == "users/1"`})
+	require.NoError(t, err)
+	require.Empty(t, response.Memos)
+
+	// A memo that fails to be published does not hold back the others.
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "broken",
+		CreatorID:  user.ID,
+		Content:    "broken",
+		Visibility: store.Private,
+		Payload: &storepb.MemoPayload{
+			Schedule: &storepb.MemoPayload_Schedule{PublishTs: publishTime.Unix(), Visibility: "UNKNOWN"},
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.Service.PublishScheduledMemos(ctx, publishTime))
+	published, err := memoService.GetMemo(ctx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PUBLIC, published.Visibility)
+	require.Nil(t, published.PublishTime)
+	response, err = memoService.ListMemos(ctx, &v1pb.ListMemosRequest{Filter: `creator == "users/1"`})
+	require.NoError(t, err)
+	require.Len(t, response.Memos, 1)
+
+	// The schedule is cleared with an empty publish time.
+	rescheduled, err := memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, PublishTime: timestamppb.New(publishTime.Add(time.Hour)), ScheduledVisibility: v1pb.Visibility_PROTECTED},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"publish_time", "scheduled_visibility"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PROTECTED, rescheduled.ScheduledVisibility)
+	unscheduled, err := memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"publish_time"}},
+	})
+	require.NoError(t, err)
+	require.Nil(t, unscheduled.PublishTime)
+}
@@ -0,0 +1,104 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoShareService(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	ts := s.Store
+	user, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-share",
+		CreatorID:  user.ID,
+		Content:    "secret",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	memoName := fmt.Sprintf("%s%d", apiv1.MemoNamePrefix, memo.ID)
+
+	// getMemo calls GetMemo anonymously.
+	getMemo := func(request *v1pb.GetMemoRequest) (*v1pb.Memo, error) {
+		return memoService.GetMemo(ctx, request)
+	}
+
+	_, err = getMemo(&v1pb.GetMemoRequest{Name: memoName})
+	require.Error(t, err)
+	_, err = memoService.CreateMemoShare(ctx, &v1pb.CreateMemoShareRequest{Parent: memoName})
+	require.Error(t, err)
+	_, err = memoService.CreateMemoShare(userCtx, &v1pb.CreateMemoShareRequest{
+		Parent: memoName,
+		Share:  &v1pb.MemoShare{ExpireTime: timestamppb.New(time.Now().Add(-time.Hour))},
+	})
+	require.Error(t, err)
+	share, err := memoService.CreateMemoShare(userCtx, &v1pb.CreateMemoShareRequest{Parent: memoName})
+	require.NoError(t, err)
+	require.Len(t, share.Token, 32)
+	require.False(t, share.HasPassword)
+	protectedShare, err := memoService.CreateMemoShare(userCtx, &v1pb.CreateMemoShareRequest{
+		Parent: memoName,
+		Share: &v1pb.MemoShare{
+			ExpireTime: timestamppb.New(time.Now().Add(time.Hour)),
+			Password:   "password",
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, protectedShare.HasPassword)
+	require.NotNil(t, protectedShare.ExpireTime)
+
+	sharedMemo, err := getMemo(&v1pb.GetMemoRequest{Name: memoName, ShareToken: share.Token})
+	require.NoError(t, err)
+	require.Equal(t, "secret", sharedMemo.Content)
+	_, err = getMemo(&v1pb.GetMemoRequest{Name: memoName, ShareToken: protectedShare.Token})
+	require.Error(t, err)
+	_, err = getMemo(&v1pb.GetMemoRequest{Name: memoName, ShareToken: protectedShare.Token, SharePassword: "wrong"})
+	require.Error(t, err)
+	_, err = getMemo(&v1pb.GetMemoRequest{Name: memoName, ShareToken: protectedShare.Token, SharePassword: "password"})
+	require.NoError(t, err)
+	_, err = getMemo(&v1pb.GetMemoRequest{Name: memoName, ShareToken: "unknown"})
+	require.Error(t, err)
+
+	// The token only grants access to its own memo.
+	anotherMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "memo-private",
+		CreatorID:  user.ID,
+		Content:    "private",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	_, err = getMemo(&v1pb.GetMemoRequest{Name: fmt.Sprintf("%s%d", apiv1.MemoNamePrefix, anotherMemo.ID), ShareToken: share.Token})
+	require.Error(t, err)
+
+	// Expired links no longer grant access.
+	_, err = ts.CreateMemoShareLink(ctx, &store.MemoShareLink{
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+		Token:     "expired-token",
+		ExpireTs:  time.Now().Add(-time.Minute).Unix(),
+	})
+	require.NoError(t, err)
+	_, err = getMemo(&v1pb.GetMemoRequest{Name: memoName, ShareToken: "expired-token"})
+	require.Error(t, err)
+
+	response, err := memoService.ListMemoShares(userCtx, &v1pb.ListMemoSharesRequest{Parent: memoName})
+	require.NoError(t, err)
+	require.Len(t, response.Shares, 3)
+	_, err = memoService.RevokeMemoShare(userCtx, &v1pb.RevokeMemoShareRequest{Name: share.Name})
+	require.NoError(t, err)
+	_, err = getMemo(&v1pb.GetMemoRequest{Name: memoName, ShareToken: share.Token})
+	require.Error(t, err)
+	_, err = memoService.RevokeMemoShare(userCtx, &v1pb.RevokeMemoShareRequest{Name: share.Name})
+	require.Error(t, err)
+}
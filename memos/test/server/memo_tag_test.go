@@ -0,0 +1,72 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+// formatMemoTagTree formats the nodes as "tag(amount/total_amount)", with the children in brackets.
+func formatMemoTagTree(nodes []*v1pb.MemoTagNode) string {
+	formatted := []string{}
+	for _, node := range nodes {
+		s := fmt.Sprintf("%s(%d/%d)", node.Tag, node.Amount, node.TotalAmount)
+		if len(node.Children) > 0 {
+			s += "[" + formatMemoTagTree(node.Children) + "]"
+		}
+		formatted = append(formatted, s)
+	}
+	return strings.Join(formatted, " ")
+}
+
+func TestListMemoTagTree(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	for _, content := range []string{"#project/alpha/design #project/alpha", "#project/beta", "#projects", "#idea", "no tags"} {
+		_, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Content: content, Visibility: v1pb.Visibility_PRIVATE})
+		require.NoError(t, err)
+	}
+
+	response, err := memoService.ListMemoTags(userCtx, &v1pb.ListMemoTagsRequest{Parent: "memos/-", Tree: true})
+	require.NoError(t, err)
+	require.Equal(t, int32(1), response.TagAmounts["project/alpha"])
+	require.Equal(t, "idea(1/1) project(0/2)[project/alpha(1/1)[project/alpha/design(1/1)] project/beta(1/1)] projects(1/1)", formatMemoTagTree(response.TagTree))
+
+	// A tag matches the memos with any tag in its subtree, but not the ones sharing its prefix.
+	memos, err := memoService.ListMemos(userCtx, &v1pb.ListMemosRequest{Filter: `tag == "project"`})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memos.Memos))
+}
+
+func TestRenameAndDeleteMemoTagDescendants(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Content: "#a_b/c", Visibility: v1pb.Visibility_PRIVATE})
+	require.NoError(t, err)
+	otherMemo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Content: "#axb/c", Visibility: v1pb.Visibility_PRIVATE})
+	require.NoError(t, err)
+
+	// The "_" of the tag is not a wildcard, so "axb/c" is not a descendant of "a_b".
+	_, err = memoService.DeleteMemoTag(userCtx, &v1pb.DeleteMemoTagRequest{Parent: "memos/-", Tag: "a_b", IncludeDescendants: true})
+	require.NoError(t, err)
+	_, err = memoService.RenameMemoTag(userCtx, &v1pb.RenameMemoTagRequest{Parent: "memos/-", OldTag: "a_b", NewTag: "job", IncludeDescendants: true})
+	require.NoError(t, err)
+	memo, err = memoService.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.RowStatus_ARCHIVED, memo.RowStatus)
+	require.Equal(t, "#job/c", memo.Content)
+	otherMemo, err = memoService.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: otherMemo.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.RowStatus_ACTIVE, otherMemo.RowStatus)
+	require.Equal(t, "#axb/c", otherMemo.Content)
+}
@@ -0,0 +1,120 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestListTasks(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:    "# Plan\n\n- [ ] write report due:2024-06-01\n- [x] book flights 📅 2024-05-20\n\nSome text\n\n- [ ] call #mom",
+		Visibility: v1pb.Visibility_PRIVATE,
+	})
+	require.NoError(t, err)
+	_, err = memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:    "- [ ] no due date\n- [ ] overdue:2024-06-01\n- [ ] due:2024-13-01\n- [ ] pay 📅2024-06-01 bills",
+		Visibility: v1pb.Visibility_PRIVATE,
+	})
+	require.NoError(t, err)
+
+	response, err := memoService.ListTasks(userCtx, &v1pb.ListTasksRequest{Parent: memo.Name})
+	require.NoError(t, err)
+	// The tasks are ordered by due date, with the ones without any last.
+	tasks := response.Tasks
+	require.Equal(t, 3, len(tasks))
+	require.Equal(t, fmt.Sprintf("%s/tasks/1", memo.Name), tasks[0].Name)
+	require.Equal(t, int32(1), tasks[0].Position)
+	require.True(t, tasks[0].Completed)
+	require.Equal(t, int64(1716163200), tasks[0].DueTime.AsTime().Unix())
+	require.Equal(t, int32(0), tasks[1].Position)
+	require.Equal(t, "write report due:2024-06-01", tasks[1].Content)
+	require.False(t, tasks[1].Completed)
+	require.Equal(t, int64(1717200000), tasks[1].DueTime.AsTime().Unix())
+	require.Equal(t, "call #mom", tasks[2].Content)
+	require.Nil(t, tasks[2].DueTime)
+
+	listTaskContents := func(filter string) []string {
+		response, err := memoService.ListTasks(userCtx, &v1pb.ListTasksRequest{Parent: "memos/-", Filter: filter})
+		require.NoError(t, err, filter)
+		contents := []string{}
+		for _, task := range response.Tasks {
+			contents = append(contents, task.Content)
+		}
+		return contents
+	}
+	// Only the well formed dates after "due:" or "📅" are due dates.
+	require.ElementsMatch(t, []string{"write report due:2024-06-01", "book flights 📅 2024-05-20", "pay 📅2024-06-01 bills"}, listTaskContents(`has_due_time`))
+	require.ElementsMatch(t, []string{"write report due:2024-06-01", "pay 📅2024-06-01 bills"}, listTaskContents(`completed == false && has_due_time && due_time <= 1717200000 && due_time > 1716163200`))
+	require.ElementsMatch(t, []string{"write report due:2024-06-01"}, listTaskContents(`completed != true && content.contains("report")`))
+	require.ElementsMatch(t, []string{"call #mom", "no due date", "overdue:2024-06-01", "due:2024-13-01"}, listTaskContents(`has_due_time == false`))
+	for _, filter := range []string{
+		`completed || has_due_time`,
+		`due_time != 10`,
+		`completed > true`,
+		`content == "report"`,
+		`unknown == 1`,
+	} {
+		_, err := memoService.ListTasks(userCtx, &v1pb.ListTasksRequest{Parent: "memos/-", Filter: filter})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), filter)
+	}
+}
+
+func TestSetTaskCompleted(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	content := "- [ ] first\n- [ ] second\n\nSome **text**\n\n- [x] third"
+	memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Content: content, Visibility: v1pb.Visibility_PRIVATE})
+	require.NoError(t, err)
+	setTaskCompleted := func(position int, completed bool) (string, error) {
+		task, err := memoService.SetTaskCompleted(userCtx, &v1pb.SetTaskCompletedRequest{
+			Name:      fmt.Sprintf("%s/tasks/%d", memo.Name, position),
+			Completed: completed,
+		})
+		if err != nil {
+			return "", err
+		}
+		require.Equal(t, completed, task.Completed)
+		memo, err := memoService.GetMemo(userCtx, &v1pb.GetMemoRequest{Name: memo.Name})
+		require.NoError(t, err)
+		return memo.Content, nil
+	}
+
+	updated, err := setTaskCompleted(1, true)
+	require.NoError(t, err)
+	require.Equal(t, "- [ ] first\n- [x] second\n\nSome **text**\n\n- [x] third", updated)
+	updated, err = setTaskCompleted(2, false)
+	require.NoError(t, err)
+	require.Equal(t, "- [ ] first\n- [x] second\n\nSome **text**\n\n- [ ] third", updated)
+	// The content is kept as is if the task is already in the state.
+	updated, err = setTaskCompleted(0, false)
+	require.NoError(t, err)
+	require.Equal(t, "- [ ] first\n- [x] second\n\nSome **text**\n\n- [ ] third", updated)
+	_, err = setTaskCompleted(3, true)
+	require.Error(t, err)
+
+	// The tasks follow the content of the memo.
+	_, err = memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+		Memo:       &v1pb.Memo{Name: memo.Name, Content: "- [x] first"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+	})
+	require.NoError(t, err)
+	response, err := memoService.ListTasks(userCtx, &v1pb.ListTasksRequest{Parent: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Tasks))
+	require.True(t, response.Tasks[0].Completed)
+}
@@ -0,0 +1,144 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoTemplateService(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, hostCtx := s.CreateUser(ctx, t, "host", store.RoleHost)
+	user, userCtx := s.CreateUser(ctx, t, "user", store.RoleUser)
+	nickname := "Jane"
+	_, err := s.Store.UpdateUser(ctx, &store.UpdateUser{ID: user.ID, Nickname: &nickname})
+	require.NoError(t, err)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	templateService := v1pb.NewMemoTemplateServiceClient(s.Conn)
+	userService := v1pb.NewUserServiceClient(s.Conn)
+
+	_, err = templateService.CreateMemoTemplate(userCtx, &v1pb.CreateMemoTemplateRequest{
+		Template: &v1pb.MemoTemplate{Title: "Standup", Scope: v1pb.MemoTemplate_WORKSPACE},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	workspaceTemplate, err := templateService.CreateMemoTemplate(hostCtx, &v1pb.CreateMemoTemplateRequest{
+		Template: &v1pb.MemoTemplate{
+			Title:      "Standup",
+			Scope:      v1pb.MemoTemplate_WORKSPACE,
+			Content:    "Standup of {{user}} on {{weekday}}",
+			Tags:       []string{"#standup"},
+			Visibility: v1pb.Visibility_PROTECTED,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"standup"}, workspaceTemplate.Tags)
+	hostTemplate, err := templateService.CreateMemoTemplate(hostCtx, &v1pb.CreateMemoTemplateRequest{
+		Template: &v1pb.MemoTemplate{Title: "Private"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.MemoTemplate_USER, hostTemplate.Scope)
+	userTemplate, err := templateService.CreateMemoTemplate(userCtx, &v1pb.CreateMemoTemplateRequest{
+		Template: &v1pb.MemoTemplate{Title: "Daily", Content: "# {{date}} #daily", Tags: []string{"daily"}},
+	})
+	require.NoError(t, err)
+
+	// The templates of other users are hidden, while the workspace templates are listed to everyone.
+	response, err := templateService.ListMemoTemplates(userCtx, &v1pb.ListMemoTemplatesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(response.Templates))
+	require.Equal(t, userTemplate.Name, response.Templates[0].Name)
+	require.Equal(t, workspaceTemplate.Name, response.Templates[1].Name)
+	_, err = templateService.GetMemoTemplate(userCtx, &v1pb.GetMemoTemplateRequest{Name: hostTemplate.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = templateService.GetMemoTemplate(userCtx, &v1pb.GetMemoTemplateRequest{Name: workspaceTemplate.Name})
+	require.NoError(t, err)
+
+	// Only admins manage the workspace templates.
+	_, err = templateService.UpdateMemoTemplate(userCtx, &v1pb.UpdateMemoTemplateRequest{
+		Template:   &v1pb.MemoTemplate{Name: workspaceTemplate.Name, Title: "Sync"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = templateService.DeleteMemoTemplate(userCtx, &v1pb.DeleteMemoTemplateRequest{Name: workspaceTemplate.Name})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+	updatedTemplate, err := templateService.UpdateMemoTemplate(userCtx, &v1pb.UpdateMemoTemplateRequest{
+		Template:   &v1pb.MemoTemplate{Name: userTemplate.Name, Tags: []string{"daily", "journal"}},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"daily", "journal"}, updatedTemplate.Tags)
+	require.Equal(t, userTemplate.Title, updatedTemplate.Title)
+
+	// Memos created from a template get the expanded content, the missing tags and the default visibility.
+	memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Template: workspaceTemplate.Name,
+		Content:  "Done the review.",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Standup of Jane on "+time.Now().UTC().Weekday().String()+"\n\nDone the review.\n\n#standup", memo.Content)
+	require.Equal(t, v1pb.Visibility_PROTECTED, memo.Visibility)
+	require.Equal(t, []string{"standup"}, memo.Property.Tags)
+	memo, err = memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Template:   updatedTemplate.Name,
+		Visibility: v1pb.Visibility_PUBLIC,
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.Visibility_PUBLIC, memo.Visibility)
+	require.Equal(t, []string{"daily", "journal"}, memo.Property.Tags)
+	// The variables are expanded at the local time of the user.
+	_, err = userService.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+		Setting:    &v1pb.UserSetting{Timezone: "Mars/Olympus"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	for _, timezone := range []string{"Pacific/Kiritimati", "Pacific/Pago_Pago"} {
+		_, err = userService.UpdateUserSetting(userCtx, &v1pb.UpdateUserSettingRequest{
+			Setting:    &v1pb.UserSetting{Timezone: timezone},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"timezone"}},
+		})
+		require.NoError(t, err)
+		location, err := time.LoadLocation(timezone)
+		require.NoError(t, err)
+		memo, err = memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Template: updatedTemplate.Name})
+		require.NoError(t, err)
+		require.Equal(t, "# "+time.Now().In(location).Format(time.DateOnly)+" #daily\n\n#journal", memo.Content)
+	}
+	_, err = memoService.CreateMemo(hostCtx, &v1pb.CreateMemoRequest{Template: userTemplate.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = templateService.DeleteMemoTemplate(userCtx, &v1pb.DeleteMemoTemplateRequest{Name: userTemplate.Name})
+	require.NoError(t, err)
+	_, err = templateService.GetMemoTemplate(userCtx, &v1pb.GetMemoTemplateRequest{Name: userTemplate.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestInstantiateMemoTemplate(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "steven", store.RoleUser)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	templateService := v1pb.NewMemoTemplateServiceClient(s.Conn)
+	template, err := templateService.CreateMemoTemplate(userCtx, &v1pb.CreateMemoTemplateRequest{
+		Template: &v1pb.MemoTemplate{Title: "Log", Content: "# {{date}} {{time}}\n{{weekday}} by {{user}}"},
+	})
+	require.NoError(t, err)
+
+	// The user without a nickname is named by the username.
+	expand := func(now time.Time) string {
+		return fmt.Sprintf("# %s %s\n%s by steven", now.Format(time.DateOnly), now.Format("15:04"), now.Weekday())
+	}
+	before := time.Now().UTC()
+	memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Template: template.Name})
+	require.NoError(t, err)
+	require.Contains(t, []string{expand(before), expand(time.Now().UTC())}, memo.Content)
+}
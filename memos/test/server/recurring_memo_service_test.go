@@ -0,0 +1,220 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
+	"github.com/usememos/memos/test"
+)
+
+func TestRecurringMemoService(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	ts := s.Store
+	user, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	recurringMemoService := v1pb.NewRecurringMemoServiceClient(s.Conn)
+	templateService := v1pb.NewMemoTemplateServiceClient(s.Conn)
+	template, err := templateService.CreateMemoTemplate(userCtx, &v1pb.CreateMemoTemplateRequest{
+		Template: &v1pb.MemoTemplate{Title: "Retro", Content: "# Retro of {{date}}", Tags: []string{"retro"}, Visibility: v1pb.Visibility_PROTECTED},
+	})
+	require.NoError(t, err)
+	checklist, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "checklist",
+		CreatorID:  user.ID,
+		Content:    "- [ ] water the plants",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	_, err = recurringMemoService.CreateRecurringMemo(userCtx, &v1pb.CreateRecurringMemoRequest{
+		RecurringMemo: &v1pb.RecurringMemo{CronExpression: "every friday", Content: "retro"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = recurringMemoService.CreateRecurringMemo(userCtx, &v1pb.CreateRecurringMemoRequest{
+		RecurringMemo: &v1pb.RecurringMemo{CronExpression: "0 17 * * 5"},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	retro, err := recurringMemoService.CreateRecurringMemo(userCtx, &v1pb.CreateRecurringMemoRequest{
+		RecurringMemo: &v1pb.RecurringMemo{CronExpression: "0 17 * * 5", Timezone: "Europe/Berlin", Template: template.Name},
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.RecurringMemo_ACTIVE, retro.State)
+	require.NotNil(t, retro.NextRunTime)
+	reminder, err := recurringMemoService.CreateRecurringMemo(userCtx, &v1pb.CreateRecurringMemoRequest{
+		RecurringMemo: &v1pb.RecurringMemo{CronExpression: "0 9 * * *", Memo: fmt.Sprintf("%s%d", apiv1.MemoNamePrefix, checklist.ID)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "UTC", reminder.Timezone)
+
+	// The runs are scheduled from a Friday afternoon in Berlin.
+	lastRunTs := time.Date(2024, 5, 31, 12, 0, 0, 0, time.UTC).Unix()
+	for _, name := range []string{retro.Name, reminder.Name} {
+		id, err := apiv1.ExtractRecurringMemoIDFromName(name)
+		require.NoError(t, err)
+		_, err = ts.UpdateRecurringMemo(ctx, &store.UpdateRecurringMemo{ID: id, LastRunTs: &lastRunTs})
+		require.NoError(t, err)
+	}
+	// 16:30 in Berlin is before the retro.
+	require.NoError(t, s.Service.RunDueRecurringMemos(ctx, time.Date(2024, 5, 31, 14, 30, 0, 0, time.UTC)))
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memos))
+	require.NoError(t, s.Service.RunDueRecurringMemos(ctx, time.Date(2024, 5, 31, 15, 0, 0, 0, time.UTC)))
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memos))
+	retroMemo := memos[0]
+	if retroMemo.ID == checklist.ID {
+		retroMemo = memos[1]
+	}
+	require.Equal(t, "# Retro of 2024-05-31\n\n#retro", retroMemo.Content)
+	require.Equal(t, store.Protected, retroMemo.Visibility)
+	// The retro runs once a week.
+	require.NoError(t, s.Service.RunDueRecurringMemos(ctx, time.Date(2024, 5, 31, 15, 30, 0, 0, time.UTC)))
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memos))
+
+	// The reminder of the next morning goes to the inbox, and to the webhooks along with the content.
+	payloads := []*v1pb.WebhookRequestPayload{}
+	webhookServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		payload := &v1pb.WebhookRequestPayload{}
+		require.NoError(t, protojson.Unmarshal(body, payload))
+		payloads = append(payloads, payload)
+		_, _ = w.Write([]byte(`{"code":0}`))
+	}))
+	defer webhookServer.Close()
+	_, err = ts.CreateWebhook(ctx, &store.Webhook{CreatorID: user.ID, Name: "test", URL: webhookServer.URL})
+	require.NoError(t, err)
+	require.NoError(t, s.Service.RunDueRecurringMemos(ctx, time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)))
+	require.Equal(t, 1, len(payloads))
+	require.Equal(t, "memos.memo.reminded", payloads[0].ActivityType)
+	require.Equal(t, checklist.Content, payloads[0].Memo.Content)
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{ReceiverID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(inboxes))
+	require.Equal(t, storepb.InboxMessage_REMINDER, inboxes[0].Message.Type)
+	activity, err := ts.GetActivity(ctx, &store.FindActivity{ID: inboxes[0].Message.ActivityId})
+	require.NoError(t, err)
+	require.Equal(t, checklist.ID, activity.Payload.Reminder.MemoId)
+
+	paused, err := recurringMemoService.PauseRecurringMemo(userCtx, &v1pb.PauseRecurringMemoRequest{Name: reminder.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.RecurringMemo_PAUSED, paused.State)
+	require.Nil(t, paused.NextRunTime)
+	require.NoError(t, s.Service.RunDueRecurringMemos(ctx, time.Date(2024, 6, 2, 9, 0, 0, 0, time.UTC)))
+	inboxes, err = ts.ListInboxes(ctx, &store.FindInbox{ReceiverID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(inboxes))
+	resumed, err := recurringMemoService.ResumeRecurringMemo(userCtx, &v1pb.ResumeRecurringMemoRequest{Name: reminder.Name})
+	require.NoError(t, err)
+	require.Equal(t, v1pb.RecurringMemo_ACTIVE, resumed.State)
+	require.True(t, resumed.NextRunTime.AsTime().After(time.Now()))
+
+	response, err := recurringMemoService.ListRecurringMemos(userCtx, &v1pb.ListRecurringMemosRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(response.RecurringMemos))
+	_, err = recurringMemoService.DeleteRecurringMemo(userCtx, &v1pb.DeleteRecurringMemoRequest{Name: retro.Name})
+	require.NoError(t, err)
+	response, err = recurringMemoService.ListRecurringMemos(userCtx, &v1pb.ListRecurringMemosRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.RecurringMemos))
+	_, err = recurringMemoService.PauseRecurringMemo(ctx, &v1pb.PauseRecurringMemoRequest{Name: reminder.Name})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, otherUserCtx := s.CreateUser(ctx, t, "other", store.RoleUser)
+	_, err = recurringMemoService.PauseRecurringMemo(otherUserCtx, &v1pb.PauseRecurringMemoRequest{Name: reminder.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// staleDriver lists the recurring memos as they were before another server ran them.
+type staleDriver struct {
+	store.Driver
+	recurringMemos []*store.RecurringMemo
+}
+
+func (d *staleDriver) ListRecurringMemos(context.Context, *store.FindRecurringMemo) ([]*store.RecurringMemo, error) {
+	return d.recurringMemos, nil
+}
+
+func TestRunDueRecurringMemosOnSeveralServers(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	require.NoError(t, dbDriver.Migrate(ctx))
+	t.Cleanup(func() {
+		dbDriver.Close()
+	})
+	ts := store.New(dbDriver, profile)
+	user, err := ts.CreateUser(ctx, &store.User{Username: "test", Role: store.RoleHost, Email: "test@test.com"})
+	require.NoError(t, err)
+	_, err = ts.CreateRecurringMemo(ctx, &store.RecurringMemo{
+		CreatorID:      user.ID,
+		State:          store.RecurringMemoStateActive,
+		CronExpression: "0 9 * * *",
+		Timezone:       "UTC",
+		LastRunTs:      time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC).Unix(),
+		Payload:        &storepb.RecurringMemoPayload{Content: "standup"},
+	})
+	require.NoError(t, err)
+	recurringMemos, err := ts.ListRecurringMemos(ctx, &store.FindRecurringMemo{})
+	require.NoError(t, err)
+	service := apiv1.NewAPIV1Service("test-secret", profile, ts, grpc.NewServer())
+	staleService := apiv1.NewAPIV1Service("test-secret", profile, store.New(&staleDriver{Driver: dbDriver, recurringMemos: recurringMemos}, profile), grpc.NewServer())
+
+	// The other server listed the recurring memo before the run, so it finds the run already claimed.
+	now := time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)
+	require.NoError(t, service.RunDueRecurringMemos(ctx, now))
+	require.NoError(t, staleService.RunDueRecurringMemos(ctx, now.Add(time.Minute)))
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memos))
+}
+
+func TestPreviewRecurringMemoSchedule(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	recurringMemoService := v1pb.NewRecurringMemoServiceClient(s.Conn)
+	response, err := recurringMemoService.PreviewRecurringMemoSchedule(userCtx, &v1pb.PreviewRecurringMemoScheduleRequest{
+		CronExpression: "0 9 1 * *",
+		Timezone:       "Asia/Tokyo",
+		Count:          3,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(response.RunTimes))
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	for i, runTime := range response.RunTimes {
+		localRunTime := runTime.AsTime().In(tokyo)
+		require.Equal(t, 1, localRunTime.Day())
+		require.Equal(t, 9, localRunTime.Hour())
+		if i > 0 {
+			require.True(t, runTime.AsTime().After(response.RunTimes[i-1].AsTime()))
+		}
+	}
+
+	_, err = recurringMemoService.PreviewRecurringMemoSchedule(userCtx, &v1pb.PreviewRecurringMemoScheduleRequest{
+		CronExpression: "0 9 1 * *",
+		Timezone:       "Nowhere/Land",
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
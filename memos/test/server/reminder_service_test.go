@@ -0,0 +1,111 @@
+package testserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestReminderService(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	reminderService := v1pb.NewReminderServiceClient(s.Conn)
+	memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{
+		Content:    "- [ ] call mom",
+		Visibility: v1pb.Visibility_PRIVATE,
+	})
+	require.NoError(t, err)
+
+	remindTime := time.Unix(1717200000, 0)
+	reminder, err := reminderService.SetReminder(userCtx, &v1pb.SetReminderRequest{
+		Reminder: &v1pb.Reminder{Memo: memo.Name, RemindTime: timestamppb.New(remindTime)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, memo.Name, reminder.Memo)
+	require.Nil(t, reminder.TaskPosition)
+	// Setting the reminder again replaces the pending one.
+	updatedReminder, err := reminderService.SetReminder(userCtx, &v1pb.SetReminderRequest{
+		Reminder: &v1pb.Reminder{Memo: memo.Name, RemindTime: timestamppb.New(remindTime.Add(time.Hour))},
+	})
+	require.NoError(t, err)
+	require.Equal(t, reminder.Name, updatedReminder.Name)
+	require.Equal(t, remindTime.Add(time.Hour).Unix(), updatedReminder.RemindTime.AsTime().Unix())
+
+	position := int32(0)
+	taskReminder, err := reminderService.SetReminder(userCtx, &v1pb.SetReminderRequest{
+		Reminder: &v1pb.Reminder{Memo: memo.Name, TaskPosition: &position, RemindTime: timestamppb.New(remindTime)},
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, reminder.Name, taskReminder.Name)
+	missingPosition := int32(1)
+	_, err = reminderService.SetReminder(userCtx, &v1pb.SetReminderRequest{
+		Reminder: &v1pb.Reminder{Memo: memo.Name, TaskPosition: &missingPosition, RemindTime: timestamppb.New(remindTime)},
+	})
+	require.Error(t, err)
+	_, err = reminderService.SetReminder(ctx, &v1pb.SetReminderRequest{
+		Reminder: &v1pb.Reminder{Memo: memo.Name, RemindTime: timestamppb.New(remindTime)},
+	})
+	require.Error(t, err)
+
+	response, err := reminderService.ListReminders(userCtx, &v1pb.ListRemindersRequest{Memo: memo.Name})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(response.Reminders))
+	require.Equal(t, taskReminder.Name, response.Reminders[0].Name)
+
+	// Snoozing a fired reminder makes it pending again.
+	reminderID, err := apiv1.ExtractReminderIDFromName(taskReminder.Name)
+	require.NoError(t, err)
+	firedTs := time.Now().Unix()
+	_, err = s.Store.UpdateMemoReminder(ctx, &store.UpdateMemoReminder{ID: reminderID, FiredTs: &firedTs})
+	require.NoError(t, err)
+	response, err = reminderService.ListReminders(userCtx, &v1pb.ListRemindersRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Reminders))
+	snoozedReminder, err := reminderService.SnoozeReminder(userCtx, &v1pb.SnoozeReminderRequest{
+		Name:     taskReminder.Name,
+		Duration: durationpb.New(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Nil(t, snoozedReminder.FireTime)
+	require.Greater(t, snoozedReminder.RemindTime.AsTime().Unix(), firedTs)
+	_, err = reminderService.SnoozeReminder(userCtx, &v1pb.SnoozeReminderRequest{
+		Name:     taskReminder.Name,
+		Duration: durationpb.New(-time.Hour),
+	})
+	require.Error(t, err)
+
+	_, err = reminderService.DeleteReminder(userCtx, &v1pb.DeleteReminderRequest{Name: reminder.Name})
+	require.NoError(t, err)
+	response, err = reminderService.ListReminders(userCtx, &v1pb.ListRemindersRequest{IncludeFired: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Reminders))
+
+	// Task reminders follow their tasks when the content is edited, and go away with them.
+	updateContent := func(content string) {
+		_, err := memoService.UpdateMemo(userCtx, &v1pb.UpdateMemoRequest{
+			Memo:       &v1pb.Memo{Name: memo.Name, Content: content},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"content"}},
+		})
+		require.NoError(t, err)
+	}
+	updateContent("- [ ] buy milk\n- [ ] call mom")
+	response, err = reminderService.ListReminders(userCtx, &v1pb.ListRemindersRequest{IncludeFired: true})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Reminders))
+	require.Equal(t, int32(1), response.Reminders[0].GetTaskPosition())
+	updateContent("- [ ] buy milk")
+	response, err = reminderService.ListReminders(userCtx, &v1pb.ListRemindersRequest{IncludeFired: true})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(response.Reminders))
+}
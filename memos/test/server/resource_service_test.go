@@ -0,0 +1,57 @@
+package testserver
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestGetResourceBinaryOfTrashedMemo(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	user, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	resourceService := v1pb.NewResourceServiceClient(s.Conn)
+	memo, err := memoService.CreateMemo(userCtx, &v1pb.CreateMemoRequest{Content: "private", Visibility: v1pb.Visibility_PRIVATE})
+	require.NoError(t, err)
+	memoID, err := apiv1.ExtractMemoIDFromName(memo.Name)
+	require.NoError(t, err)
+	resource, err := s.Store.CreateResource(ctx, &store.Resource{
+		UID:       "resource",
+		CreatorID: user.ID,
+		Filename:  "a.txt",
+		Blob:      []byte("content"),
+		Type:      "text/plain",
+		Size:      7,
+		MemoID:    &memoID,
+	})
+	require.NoError(t, err)
+	resourceName := fmt.Sprintf("%s%d", apiv1.ResourceNamePrefix, resource.ID)
+
+	binary, err := resourceService.GetResourceBinary(userCtx, &v1pb.GetResourceBinaryRequest{Name: resourceName})
+	require.NoError(t, err)
+	require.Equal(t, []byte("content"), binary.Data)
+
+	// The resources of a memo in the trash bin are not found, even by their creator.
+	_, err = memoService.DeleteMemo(userCtx, &v1pb.DeleteMemoRequest{Name: memo.Name})
+	require.NoError(t, err)
+	_, err = resourceService.GetResourceBinary(ctx, &v1pb.GetResourceBinaryRequest{Name: resourceName})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	memoName := memo.Name
+	attached, err := resourceService.CreateResource(userCtx, &v1pb.CreateResourceRequest{
+		Resource: &v1pb.Resource{Filename: "b.txt", Content: []byte("private"), Type: "text/plain", Memo: &memoName},
+	})
+	require.NoError(t, err)
+	_, err = resourceService.GetResourceBinary(ctx, &v1pb.GetResourceBinaryRequest{Name: attached.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = resourceService.GetResourceBinary(userCtx, &v1pb.GetResourceBinaryRequest{Name: attached.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
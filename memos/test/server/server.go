@@ -0,0 +1,73 @@
+package testserver
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	apiv1 "github.com/usememos/memos/server/router/api/v1"
+	"github.com/usememos/memos/store"
+	teststore "github.com/usememos/memos/test/store"
+)
+
+const testingSecret = "test-secret"
+
+// TestingServer serves the API of a testing store over an in-memory connection,
+// so requests go through the same interceptors as the ones of clients.
+type TestingServer struct {
+	Store   *store.Store
+	Service *apiv1.APIV1Service
+	Conn    *grpc.ClientConn
+}
+
+func NewTestingServer(ctx context.Context, t *testing.T) *TestingServer {
+	ts := teststore.NewTestingStore(ctx, t)
+	authInterceptor := apiv1.NewGRPCAuthInterceptor(ts, testingSecret)
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(authInterceptor.AuthenticationInterceptor),
+		grpc.ChainStreamInterceptor(authInterceptor.StreamAuthenticationInterceptor),
+	)
+	service := apiv1.NewAPIV1Service(testingSecret, ts.Profile, ts, grpcServer)
+	listener := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+		grpcServer.Stop()
+		ts.Close()
+	})
+	return &TestingServer{
+		Store:   ts,
+		Service: service,
+		Conn:    conn,
+	}
+}
+
+// CreateUser creates the user and returns it with the context whose requests are signed in as it.
+func (s *TestingServer) CreateUser(ctx context.Context, t *testing.T, username string, role store.Role) (*store.User, context.Context) {
+	user, err := s.Store.CreateUser(ctx, &store.User{
+		Username: username,
+		Role:     role,
+		Email:    username + "@test.com",
+	})
+	require.NoError(t, err)
+	accessToken, err := apiv1.GenerateAccessToken(user.Username, user.ID, time.Now().Add(time.Hour), []byte(testingSecret))
+	require.NoError(t, err)
+	require.NoError(t, s.Service.UpsertAccessTokenToStore(ctx, user, accessToken, "test"))
+	return user, metadata.AppendToOutgoingContext(ctx, "Authorization", "Bearer "+accessToken)
+}
@@ -0,0 +1,91 @@
+package testserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	v1pb "github.com/usememos/memos/proto/gen/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func TestTagService(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	_, otherUserCtx := s.CreateUser(ctx, t, "other", store.RoleUser)
+	tagService := v1pb.NewTagServiceClient(s.Conn)
+	tag, err := tagService.CreateTag(userCtx, &v1pb.CreateTagRequest{Tag: &v1pb.Tag{Tag: "#idea", Color: "#fff", Emoji: "💡"}})
+	require.NoError(t, err)
+	require.Equal(t, "idea", tag.Tag)
+	_, err = tagService.CreateTag(userCtx, &v1pb.CreateTagRequest{Tag: &v1pb.Tag{Tag: "idea"}})
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	for _, name := range []string{"project/alpha", "日本"} {
+		_, err := tagService.CreateTag(userCtx, &v1pb.CreateTagRequest{Tag: &v1pb.Tag{Tag: name}})
+		require.NoError(t, err, name)
+	}
+	for _, name := range []string{"", "a b", "##a", "/a", "a/", "a//b"} {
+		_, err := tagService.CreateTag(userCtx, &v1pb.CreateTagRequest{Tag: &v1pb.Tag{Tag: name}})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+	_, err = tagService.CreateTag(userCtx, &v1pb.CreateTagRequest{Tag: &v1pb.Tag{Tag: "colored", Color: "red"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	tag, err = tagService.UpdateTag(userCtx, &v1pb.UpdateTagRequest{
+		Tag:        &v1pb.Tag{Name: tag.Name, Tag: "ideas", Pinned: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tag", "pinned"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "ideas", tag.Tag)
+	require.True(t, tag.Pinned)
+	require.Equal(t, "#fff", tag.Color)
+
+	// The tags are only visible to their creators.
+	_, err = tagService.GetTag(otherUserCtx, &v1pb.GetTagRequest{Name: tag.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = tagService.DeleteTag(otherUserCtx, &v1pb.DeleteTagRequest{Name: tag.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = tagService.DeleteTag(userCtx, &v1pb.DeleteTagRequest{Name: tag.Name})
+	require.NoError(t, err)
+	response, err := tagService.ListTags(userCtx, &v1pb.ListTagsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(response.Tags))
+}
+
+func TestRenameAndDeleteTags(t *testing.T) {
+	ctx := context.Background()
+	s := NewTestingServer(ctx, t)
+	_, userCtx := s.CreateUser(ctx, t, "test", store.RoleHost)
+	memoService := v1pb.NewMemoServiceClient(s.Conn)
+	tagService := v1pb.NewTagServiceClient(s.Conn)
+	for _, name := range []string{"project", "project/alpha", "work", "work/alpha", "projects"} {
+		_, err := tagService.CreateTag(userCtx, &v1pb.CreateTagRequest{Tag: &v1pb.Tag{Tag: name, Color: "#000000"}})
+		require.NoError(t, err)
+	}
+	listTagNames := func() []string {
+		response, err := tagService.ListTags(userCtx, &v1pb.ListTagsRequest{})
+		require.NoError(t, err)
+		names := []string{}
+		for _, tag := range response.Tags {
+			names = append(names, tag.Tag)
+		}
+		return names
+	}
+
+	// The tags follow the memo tags renamed in all memos. "work/alpha" already exists, so the renamed "project/alpha" is dropped.
+	_, err := memoService.RenameMemoTag(userCtx, &v1pb.RenameMemoTagRequest{Parent: "memos/-", OldTag: "project", NewTag: "work", IncludeDescendants: true})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"projects", "work", "work/alpha"}, listTagNames())
+	_, err = memoService.RenameMemoTag(userCtx, &v1pb.RenameMemoTagRequest{Parent: "memos/-", OldTag: "work", NewTag: "job"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"job", "projects", "work/alpha"}, listTagNames())
+
+	_, err = memoService.DeleteMemoTag(userCtx, &v1pb.DeleteMemoTagRequest{Parent: "memos/-", Tag: "work", IncludeDescendants: true})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"job", "projects"}, listTagNames())
+}
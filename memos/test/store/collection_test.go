@@ -0,0 +1,104 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestCollectionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	collection, err := ts.CreateCollection(ctx, &store.Collection{
+		CreatorID:   user.ID,
+		Title:       "Reading",
+		Description: "Books to read",
+		Visibility:  store.Private,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateCollection(ctx, &store.Collection{
+		CreatorID:  user.ID,
+		Title:      "Recipes",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	collections, err := ts.ListCollections(ctx, &store.FindCollection{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(collections))
+	collections, err = ts.ListCollections(ctx, &store.FindCollection{
+		CreatorID:      &user.ID,
+		VisibilityList: []store.Visibility{store.Public, store.Protected},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+	require.Equal(t, "Recipes", collections[0].Title)
+
+	title, visibility := "Library", store.Protected
+	updatedCollection, err := ts.UpdateCollection(ctx, &store.UpdateCollection{
+		ID:         collection.ID,
+		Title:      &title,
+		Visibility: &visibility,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Library", updatedCollection.Title)
+	require.Equal(t, "Books to read", updatedCollection.Description)
+	require.Equal(t, store.Protected, updatedCollection.Visibility)
+
+	memoIDs := []int32{}
+	for _, uid := range []string{"first", "second", "third"} {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    uid,
+			Visibility: store.Private,
+		})
+		require.NoError(t, err)
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	// The memos are listed by their positions rather than the order they are added in.
+	for i, position := range []int32{2, 0, 1} {
+		_, err := ts.UpsertCollectionMemo(ctx, &store.CollectionMemo{
+			CollectionID: collection.ID,
+			MemoID:       memoIDs[i],
+			Position:     position,
+		})
+		require.NoError(t, err)
+	}
+	collectionMemos, err := ts.ListCollectionMemos(ctx, &store.FindCollectionMemo{CollectionID: &collection.ID})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(collectionMemos))
+	require.Equal(t, memoIDs[1], collectionMemos[0].MemoID)
+	require.Equal(t, memoIDs[2], collectionMemos[1].MemoID)
+	require.Equal(t, memoIDs[0], collectionMemos[2].MemoID)
+
+	// Upserting moves the memo instead of adding it twice.
+	_, err = ts.UpsertCollectionMemo(ctx, &store.CollectionMemo{
+		CollectionID: collection.ID,
+		MemoID:       memoIDs[0],
+		Position:     -1,
+	})
+	require.NoError(t, err)
+	collectionMemos, err = ts.ListCollectionMemos(ctx, &store.FindCollectionMemo{CollectionID: &collection.ID})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(collectionMemos))
+	require.Equal(t, memoIDs[0], collectionMemos[0].MemoID)
+
+	// Purging a memo removes it from the collections.
+	require.NoError(t, ts.PurgeMemo(ctx, memoIDs[1]))
+	collectionMemos, err = ts.ListCollectionMemos(ctx, &store.FindCollectionMemo{CollectionID: &collection.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(collectionMemos))
+
+	require.NoError(t, ts.DeleteCollection(ctx, &store.DeleteCollection{ID: collection.ID}))
+	collections, err = ts.ListCollections(ctx, &store.FindCollection{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collections))
+	ts.Close()
+}
@@ -58,5 +58,120 @@ func TestMemoRelationStore(t *testing.T) {
 	}
 	_, err = ts.UpsertMemoRelation(ctx, commentRelation)
 	require.NoError(t, err)
+	// Upserting an existing relation is a no-op.
+	_, err = ts.UpsertMemoRelation(ctx, referenceRelation)
+	require.NoError(t, err)
+	memoRelations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoRelations))
+	ts.Close()
+}
+
+func TestMemoGraphStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	// a -> b -> c -> d, b -> private, and c comments on a.
+	memos := map[string]*store.Memo{}
+	for _, uid := range []string{"memo-a", "memo-b", "memo-c", "memo-d", "private"} {
+		visibility := store.Public
+		if uid == "private" {
+			visibility = store.Private
+		}
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    uid,
+			Visibility: visibility,
+		})
+		require.NoError(t, err)
+		memos[uid] = memo
+	}
+	for _, relation := range []struct {
+		from, to     string
+		relationType store.MemoRelationType
+	}{
+		{"memo-a", "memo-b", store.MemoRelationReference},
+		{"memo-b", "memo-c", store.MemoRelationReference},
+		{"memo-c", "memo-d", store.MemoRelationReference},
+		{"memo-b", "private", store.MemoRelationReference},
+		{"memo-c", "memo-a", store.MemoRelationComment},
+	} {
+		_, err := ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+			MemoID:        memos[relation.from].ID,
+			RelatedMemoID: memos[relation.to].ID,
+			Type:          relation.relationType,
+		})
+		require.NoError(t, err)
+	}
+	listNodes := func(find *store.FindMemoGraph) map[int32]int {
+		nodes, err := ts.ListMemoGraphNodes(ctx, find)
+		require.NoError(t, err)
+		depthByID := map[int32]int{}
+		for _, node := range nodes {
+			depthByID[node.MemoID] = node.Depth
+		}
+		return depthByID
+	}
+
+	publicFilter := &store.MemoFilterCondition{Field: store.MemoFilterFieldVisibility, Operator: store.MemoFilterOperatorIn, Value: []any{store.Public.String()}}
+
+	// The comment relation makes memo-c one relation away from memo-a.
+	require.Equal(t, map[int32]int{
+		memos["memo-a"].ID:  0,
+		memos["memo-b"].ID:  1,
+		memos["memo-c"].ID:  1,
+		memos["memo-d"].ID:  2,
+		memos["private"].ID: 2,
+	}, listNodes(&store.FindMemoGraph{
+		RootMemoIDList: []int32{memos["memo-a"].ID},
+		MaxDepth:       2,
+	}))
+	// Relations are traversed in either direction, only through the memos visible to the viewer.
+	require.Equal(t, map[int32]int{
+		memos["memo-d"].ID: 0,
+		memos["memo-c"].ID: 1,
+		memos["memo-b"].ID: 2,
+		memos["memo-a"].ID: 3,
+	}, listNodes(&store.FindMemoGraph{
+		RootMemoIDList: []int32{memos["memo-d"].ID},
+		MaxDepth:       5,
+		TypeList:       []store.MemoRelationType{store.MemoRelationReference},
+		Filter:         publicFilter,
+	}))
+	require.Equal(t, map[int32]int{}, listNodes(&store.FindMemoGraph{
+		RootMemoIDList: []int32{memos["private"].ID},
+		MaxDepth:       1,
+		Filter:         publicFilter,
+	}))
+	// The filter applies to the memos reached in the recursive query, including the memos shared with the viewer.
+	viewer, err := ts.CreateUser(ctx, &store.User{Username: "viewer", Role: store.RoleUser, Email: "viewer@test.com"})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoShare(ctx, &store.MemoShare{
+		MemoID:     memos["private"].ID,
+		CreatorID:  user.ID,
+		UserID:     viewer.ID,
+		Permission: store.MemoSharePermissionRead,
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[int32]int{
+		memos["memo-a"].ID:  0,
+		memos["memo-b"].ID:  1,
+		memos["private"].ID: 2,
+	}, listNodes(&store.FindMemoGraph{
+		RootMemoIDList: []int32{memos["memo-a"].ID},
+		MaxDepth:       2,
+		TypeList:       []store.MemoRelationType{store.MemoRelationReference},
+		Filter: &store.MemoFilterOr{Filters: []store.MemoFilter{
+			&store.MemoFilterAnd{Filters: []store.MemoFilter{
+				publicFilter,
+				&store.MemoFilterNot{Filter: &store.MemoFilterCondition{Field: store.MemoFilterFieldContent, Operator: store.MemoFilterOperatorEqual, Value: "memo-c"}},
+			}},
+			&store.MemoFilterCondition{Field: store.MemoFilterFieldSharedWith, Operator: store.MemoFilterOperatorEqual, Value: viewer.ID},
+		}},
+	}))
 	ts.Close()
 }
@@ -0,0 +1,77 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoReminderStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "- [ ] call mom",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	position := int32(0)
+	taskReminder, err := ts.CreateMemoReminder(ctx, &store.MemoReminder{
+		MemoID:       memo.ID,
+		CreatorID:    user.ID,
+		TaskPosition: &position,
+		RemindTs:     200,
+	})
+	require.NoError(t, err)
+	memoReminder, err := ts.CreateMemoReminder(ctx, &store.MemoReminder{
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+		RemindTs:  100,
+	})
+	require.NoError(t, err)
+
+	reminders, err := ts.ListMemoReminders(ctx, &store.FindMemoReminder{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoReminder{memoReminder, taskReminder}, reminders)
+
+	hasTask := true
+	reminders, err = ts.ListMemoReminders(ctx, &store.FindMemoReminder{
+		HasTask: &hasTask,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(reminders))
+	require.Equal(t, position, *reminders[0].TaskPosition)
+
+	firedTs := int64(150)
+	updatedReminder, err := ts.UpdateMemoReminder(ctx, &store.UpdateMemoReminder{
+		ID:      memoReminder.ID,
+		FiredTs: &firedTs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, firedTs, updatedReminder.FiredTs)
+	require.Equal(t, int64(100), updatedReminder.RemindTs)
+
+	pending, remindTsBefore := true, int64(201)
+	reminders, err = ts.ListMemoReminders(ctx, &store.FindMemoReminder{
+		Pending:        &pending,
+		RemindTsBefore: &remindTsBefore,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoReminder{taskReminder}, reminders)
+
+	// The reminders are deleted along with the memo.
+	require.NoError(t, ts.PurgeMemo(ctx, memo.ID))
+	reminders, err = ts.ListMemoReminders(ctx, &store.FindMemoReminder{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(reminders))
+	ts.Close()
+}
@@ -0,0 +1,137 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoRevisionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	// Updating with the same content does not create a revision.
+	content := "test_content"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Content: &content,
+	})
+	require.NoError(t, err)
+	memoRevisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoRevisions))
+
+	content = "test_content_2"
+	visibility := store.Private
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:         memo.ID,
+		Content:    &content,
+		Visibility: &visibility,
+	})
+	require.NoError(t, err)
+	memoRevisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoRevisions))
+	require.Equal(t, "test_content", memoRevisions[0].Content)
+	require.Equal(t, store.Public, memoRevisions[0].Visibility)
+	require.Equal(t, user.ID, memoRevisions[0].CreatorID)
+
+	memoRevision, err := ts.GetMemoRevision(ctx, &store.FindMemoRevision{
+		ID: &memoRevisions[0].ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, memoRevisions[0], memoRevision)
+
+	err = ts.DeleteMemoRevision(ctx, &store.DeleteMemoRevision{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	memoRevisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoRevisions))
+	ts.Close()
+}
+
+func TestMemoRevisionLimit(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_MEMO_RELATED,
+		Value: &storepb.WorkspaceSetting_MemoRelatedSetting{
+			MemoRelatedSetting: &storepb.WorkspaceMemoRelatedSetting{
+				MemoRevisionLimit: proto.Int32(3),
+			},
+		},
+	})
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "content_0",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	for i := 1; i <= 5; i++ {
+		content := fmt.Sprintf("content_%d", i)
+		err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:      memo.ID,
+			Content: &content,
+		})
+		require.NoError(t, err)
+	}
+	memoRevisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(memoRevisions))
+	require.Equal(t, "content_4", memoRevisions[0].Content)
+	require.Equal(t, "content_2", memoRevisions[2].Content)
+
+	// No revisions are recorded with a limit of 0.
+	_, err = ts.UpsertWorkspaceSetting(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_MEMO_RELATED,
+		Value: &storepb.WorkspaceSetting_MemoRelatedSetting{
+			MemoRelatedSetting: &storepb.WorkspaceMemoRelatedSetting{
+				MemoRevisionLimit: proto.Int32(0),
+			},
+		},
+	})
+	require.NoError(t, err)
+	content := "content_6"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memo.ID,
+		Content: &content,
+	})
+	require.NoError(t, err)
+	memoRevisions, err = ts.ListMemoRevisions(ctx, &store.FindMemoRevision{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(memoRevisions))
+	require.Equal(t, "content_4", memoRevisions[0].Content)
+	ts.Close()
+}
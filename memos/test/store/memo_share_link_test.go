@@ -0,0 +1,69 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoShareLinkStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	link, err := ts.CreateMemoShareLink(ctx, &store.MemoShareLink{
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+		Token:     "token",
+		ExpireTs:  100,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, link.CreatedTs)
+	_, err = ts.CreateMemoShareLink(ctx, &store.MemoShareLink{
+		MemoID:       memo.ID,
+		CreatorID:    user.ID,
+		Token:        "another-token",
+		PasswordHash: "hash",
+	})
+	require.NoError(t, err)
+	// Tokens are unique.
+	_, err = ts.CreateMemoShareLink(ctx, &store.MemoShareLink{
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+		Token:     "token",
+	})
+	require.Error(t, err)
+
+	links, err := ts.ListMemoShareLinks(ctx, &store.FindMemoShareLink{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Len(t, links, 2)
+	token := "token"
+	found, err := ts.GetMemoShareLink(ctx, &store.FindMemoShareLink{Token: &token})
+	require.NoError(t, err)
+	require.Equal(t, link.ID, found.ID)
+	require.Equal(t, int64(100), found.ExpireTs)
+	require.Empty(t, found.PasswordHash)
+
+	require.NoError(t, ts.DeleteMemoShareLink(ctx, &store.DeleteMemoShareLink{ID: &link.ID}))
+	found, err = ts.GetMemoShareLink(ctx, &store.FindMemoShareLink{Token: &token})
+	require.NoError(t, err)
+	require.Nil(t, found)
+
+	// Purging the memo deletes its share links.
+	require.NoError(t, ts.PurgeMemo(ctx, memo.ID))
+	links, err = ts.ListMemoShareLinks(ctx, &store.FindMemoShareLink{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Empty(t, links)
+	ts.Close()
+}
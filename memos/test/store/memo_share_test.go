@@ -0,0 +1,73 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoShareStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	colleague, err := ts.CreateUser(ctx, &store.User{
+		Username: "colleague",
+		Role:     store.RoleUser,
+		Email:    "colleague@test.com",
+	})
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "shared-memo",
+		CreatorID:  user.ID,
+		Content:    "shared",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "private-memo",
+		CreatorID:  user.ID,
+		Content:    "private",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	share, err := ts.UpsertMemoShare(ctx, &store.MemoShare{
+		MemoID:     memo.ID,
+		CreatorID:  user.ID,
+		UserID:     colleague.ID,
+		Permission: store.MemoSharePermissionRead,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.MemoSharePermissionRead, share.Permission)
+	// Sharing again updates the permission.
+	updatedShare, err := ts.UpsertMemoShare(ctx, &store.MemoShare{
+		MemoID:     memo.ID,
+		CreatorID:  user.ID,
+		UserID:     colleague.ID,
+		Permission: store.MemoSharePermissionEdit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, share.ID, updatedShare.ID)
+	require.Equal(t, store.MemoSharePermissionEdit, updatedShare.Permission)
+	shares, err := ts.ListMemoShares(ctx, &store.FindMemoShare{UserID: &colleague.ID})
+	require.NoError(t, err)
+	require.Len(t, shares, 1)
+
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{
+		Filter: &store.MemoFilterCondition{Field: store.MemoFilterFieldSharedWith, Operator: store.MemoFilterOperatorEqual, Value: colleague.ID},
+	})
+	require.NoError(t, err)
+	require.Len(t, memos, 1)
+	require.Equal(t, memo.ID, memos[0].ID)
+
+	// Purging the memo deletes its shares.
+	require.NoError(t, ts.PurgeMemo(ctx, memo.ID))
+	shares, err = ts.ListMemoShares(ctx, &store.FindMemoShare{UserID: &colleague.ID})
+	require.NoError(t, err)
+	require.Empty(t, shares)
+	ts.Close()
+}
@@ -0,0 +1,99 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoTaskStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-resource-name",
+		CreatorID:  user.ID,
+		Content:    "- [ ] write report due:2024-06-01\n- [x] book flights",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	dueTs := int64(1717200000)
+	task, err := ts.CreateMemoTask(ctx, &store.MemoTask{
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+		Position:  0,
+		Content:   "write report due:2024-06-01",
+		DueTs:     &dueTs,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, task.ID)
+	_, err = ts.CreateMemoTask(ctx, &store.MemoTask{
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+		Position:  1,
+		Content:   "book flights",
+		Completed: true,
+	})
+	require.NoError(t, err)
+	// Positions are unique per memo.
+	_, err = ts.CreateMemoTask(ctx, &store.MemoTask{
+		MemoID:    memo.ID,
+		CreatorID: user.ID,
+		Position:  1,
+		Content:   "duplicated",
+	})
+	require.Error(t, err)
+
+	tasks, err := ts.ListMemoTasks(ctx, &store.FindMemoTask{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tasks))
+	// Tasks with a due time are listed first.
+	require.Equal(t, task, tasks[0])
+
+	completed := false
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{
+		CreatorID: &user.ID,
+		Completed: &completed,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tasks))
+	require.Equal(t, int32(0), tasks[0].Position)
+
+	hasDue, before := false, dueTs
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{
+		HasDue: &hasDue,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tasks))
+	require.Equal(t, "book flights", tasks[0].Content)
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{
+		DueTsBefore: &before,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(tasks))
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{
+		ContentSearch: []string{"flight"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tasks))
+
+	// The tasks of the memos in the trash bin are not listed.
+	require.NoError(t, ts.TrashMemo(ctx, memo.ID))
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(tasks))
+
+	require.NoError(t, ts.DeleteMemoTask(ctx, &store.DeleteMemoTask{
+		MemoID: memo.ID,
+	}))
+	ts.Close()
+}
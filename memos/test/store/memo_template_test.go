@@ -0,0 +1,73 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoTemplateStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	userTemplate, err := ts.CreateMemoTemplate(ctx, &store.MemoTemplate{
+		CreatorID:  user.ID,
+		Scope:      store.MemoTemplateScopeUser,
+		Title:      "Daily",
+		Content:    "# {{date}}",
+		Visibility: store.Private,
+		Payload:    &storepb.MemoTemplatePayload{Tags: []string{"daily"}},
+	})
+	require.NoError(t, err)
+	workspaceTemplate, err := ts.CreateMemoTemplate(ctx, &store.MemoTemplate{
+		CreatorID:  user.ID,
+		Scope:      store.MemoTemplateScopeWorkspace,
+		Title:      "Meeting",
+		Content:    "## Attendees",
+		Visibility: store.Protected,
+	})
+	require.NoError(t, err)
+
+	templates, err := ts.ListMemoTemplates(ctx, &store.FindMemoTemplate{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(templates))
+	require.Equal(t, userTemplate.ID, templates[0].ID)
+	require.Equal(t, []string{"daily"}, templates[0].Payload.Tags)
+
+	scope := store.MemoTemplateScopeWorkspace
+	templates, err = ts.ListMemoTemplates(ctx, &store.FindMemoTemplate{
+		Scope: &scope,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(templates))
+	require.Equal(t, workspaceTemplate.ID, templates[0].ID)
+	require.Equal(t, store.Protected, templates[0].Visibility)
+
+	title, visibility := "Journal", store.Public
+	updatedTemplate, err := ts.UpdateMemoTemplate(ctx, &store.UpdateMemoTemplate{
+		ID:         userTemplate.ID,
+		Title:      &title,
+		Visibility: &visibility,
+		Payload:    &storepb.MemoTemplatePayload{Tags: []string{"journal"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, title, updatedTemplate.Title)
+	require.Equal(t, visibility, updatedTemplate.Visibility)
+	require.Equal(t, "# {{date}}", updatedTemplate.Content)
+	require.Equal(t, []string{"journal"}, updatedTemplate.Payload.Tags)
+
+	err = ts.DeleteMemoTemplate(ctx, &store.DeleteMemoTemplate{ID: userTemplate.ID})
+	require.NoError(t, err)
+	template, err := ts.GetMemoTemplate(ctx, &store.FindMemoTemplate{ID: &userTemplate.ID})
+	require.NoError(t, err)
+	require.Nil(t, template)
+	ts.Close()
+}
@@ -2,10 +2,12 @@ package teststore
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -82,3 +84,230 @@ func TestDeleteMemoStore(t *testing.T) {
 	require.NoError(t, err)
 	ts.Close()
 }
+
+func TestMemoContentQueryStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for i, content := range []string{"hello and some other unrelated words", "hello hello", "你好世界", "nothing"} {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("test-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    content,
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+	}
+
+	query := "hello"
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+		ContentQuery:     &query,
+		OrderByRelevance: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoList))
+	require.Equal(t, "hello hello", memoList[0].Content)
+
+	query = "你好世"
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{
+		ContentQuery: &query,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoList))
+
+	// The index is kept in sync when the content is updated.
+	content := "goodbye world"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:      memoList[0].ID,
+		Content: &content,
+	})
+	require.NoError(t, err)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{
+		ContentQuery: &query,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoList))
+	query = "goodbye"
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{
+		ContentQuery: &query,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoList))
+
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{
+		ID: memoList[0].ID,
+	})
+	require.NoError(t, err)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{
+		ContentQuery: &query,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoList))
+	ts.Close()
+}
+
+func TestMemoFilterStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	for i, tags := range [][]string{{"work"}, {"work/project", "idea"}, {"life"}} {
+		_, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("test-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("memo %d", i),
+			Visibility: store.Public,
+			Payload: &storepb.MemoPayload{
+				Property: &storepb.MemoPayload_Property{
+					Tags:    tags,
+					HasCode: i == 0,
+				},
+			},
+		})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		filter store.MemoFilter
+		want   int
+	}{
+		{
+			filter: &store.MemoFilterOr{Filters: []store.MemoFilter{
+				&store.MemoFilterCondition{Field: store.MemoFilterFieldTag, Operator: store.MemoFilterOperatorEqual, Value: "idea"},
+				&store.MemoFilterCondition{Field: store.MemoFilterFieldTag, Operator: store.MemoFilterOperatorEqual, Value: "life"},
+			}},
+			want: 2,
+		},
+		{
+			filter: &store.MemoFilterCondition{Field: store.MemoFilterFieldTag, Operator: store.MemoFilterOperatorStartsWith, Value: "work"},
+			want:   2,
+		},
+		// Wildcards of LIKE patterns are matched literally.
+		{
+			filter: &store.MemoFilterCondition{Field: store.MemoFilterFieldTag, Operator: store.MemoFilterOperatorStartsWith, Value: "wor_"},
+			want:   0,
+		},
+		{
+			filter: &store.MemoFilterCondition{Field: store.MemoFilterFieldContent, Operator: store.MemoFilterOperatorContains, Value: "%"},
+			want:   0,
+		},
+		{
+			filter: &store.MemoFilterCondition{Field: store.MemoFilterFieldTag, Operator: store.MemoFilterOperatorEqual, Value: `work"`},
+			want:   0,
+		},
+		{
+			filter: &store.MemoFilterCondition{Field: store.MemoFilterFieldTag, Operator: store.MemoFilterOperatorSubtree, Value: "work"},
+			want:   2,
+		},
+		{
+			filter: &store.MemoFilterCondition{Field: store.MemoFilterFieldTag, Operator: store.MemoFilterOperatorSubtree, Value: "wor"},
+			want:   0,
+		},
+		{
+			filter: &store.MemoFilterCondition{Field: store.MemoFilterFieldTag, Operator: store.MemoFilterOperatorSubtree, Value: "wor_"},
+			want:   0,
+		},
+		{
+			filter: &store.MemoFilterNot{Filter: &store.MemoFilterCondition{Field: store.MemoFilterFieldHasCode, Operator: store.MemoFilterOperatorEqual, Value: true}},
+			want:   2,
+		},
+		{
+			filter: &store.MemoFilterCondition{Field: store.MemoFilterFieldContent, Operator: store.MemoFilterOperatorIn, Value: []any{"memo 0", "memo 2"}},
+			want:   2,
+		},
+		{
+			filter: &store.MemoFilterAnd{Filters: []store.MemoFilter{
+				&store.MemoFilterCondition{Field: store.MemoFilterFieldCreatedTs, Operator: store.MemoFilterOperatorGreater, Value: int64(0)},
+				&store.MemoFilterCondition{Field: store.MemoFilterFieldContent, Operator: store.MemoFilterOperatorContains, Value: "1"},
+			}},
+			want: 1,
+		},
+	}
+	for _, test := range tests {
+		memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+			Filter: test.filter,
+		})
+		require.NoError(t, err)
+		require.Equal(t, test.want, len(memoList))
+	}
+	ts.Close()
+}
+
+func TestMemoCursorStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	// Memos share the same created_ts so that the id breaks the ties.
+	createdTs := int64(1700000000)
+	memoIDs := []int32{}
+	for i := 0; i < 5; i++ {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("test-memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("memo %d", i),
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:        memo.ID,
+			CreatedTs: &createdTs,
+		})
+		require.NoError(t, err)
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	_, err = ts.UpsertMemoOrganizer(ctx, &store.MemoOrganizer{
+		MemoID: memoIDs[1],
+		UserID: user.ID,
+		Pinned: true,
+	})
+	require.NoError(t, err)
+
+	limit := 2
+	memoFind := &store.FindMemo{
+		CreatorID:     &user.ID,
+		OrderByPinned: true,
+		Limit:         &limit,
+	}
+	listedIDs := []int32{}
+	for {
+		memoList, err := ts.ListMemos(ctx, memoFind)
+		require.NoError(t, err)
+		for _, memo := range memoList {
+			listedIDs = append(listedIDs, memo.ID)
+		}
+		if len(memoList) < limit {
+			break
+		}
+		lastMemo := memoList[len(memoList)-1]
+		memoFind.Cursor = &store.MemoCursor{
+			Pinned:    lastMemo.Pinned,
+			DisplayTs: lastMemo.CreatedTs,
+			ID:        lastMemo.ID,
+		}
+	}
+	require.Equal(t, []int32{memoIDs[1], memoIDs[4], memoIDs[3], memoIDs[2], memoIDs[0]}, listedIDs)
+
+	// Comments of a memo are found by the parent id.
+	comment, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-comment",
+		CreatorID:  user.ID,
+		Content:    "comment",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        comment.ID,
+		RelatedMemoID: memoIDs[0],
+		Type:          store.MemoRelationComment,
+	})
+	require.NoError(t, err)
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+		ParentID: &memoIDs[0],
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoList))
+	require.Equal(t, comment.ID, memoList[0].ID)
+	ts.Close()
+}
@@ -0,0 +1,96 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoTrashStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	comment, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-comment",
+		CreatorID:  user.ID,
+		Content:    "test_comment",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        comment.ID,
+		RelatedMemoID: memo.ID,
+		Type:          store.MemoRelationComment,
+	})
+	require.NoError(t, err)
+	resource, err := ts.CreateResource(ctx, &store.Resource{
+		UID:       shortuuid.New(),
+		CreatorID: user.ID,
+		Filename:  "test.txt",
+		Blob:      []byte("test"),
+		Type:      "text/plain",
+		Size:      4,
+		MemoID:    &memo.ID,
+	})
+	require.NoError(t, err)
+
+	// Trashing a memo also trashes its comments and resources.
+	err = ts.TrashMemo(ctx, memo.ID)
+	require.NoError(t, err)
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoList))
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID, Trashed: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoList))
+	require.NotZero(t, memoList[0].DeletedTs)
+	resources, err := ts.ListResources(ctx, &store.FindResource{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resources))
+
+	err = ts.RestoreMemo(ctx, memo.ID)
+	require.NoError(t, err)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoList))
+	resources, err = ts.ListResources(ctx, &store.FindResource{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resources))
+	require.Equal(t, resource.ID, resources[0].ID)
+
+	// Memos trashed within the retention period are kept.
+	err = ts.TrashMemo(ctx, memo.ID)
+	require.NoError(t, err)
+	err = ts.PurgeTrashedMemos(ctx)
+	require.NoError(t, err)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID, Trashed: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoList))
+
+	deletedTs := int64(1)
+	for _, memo := range memoList {
+		err = ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, DeletedTs: &deletedTs})
+		require.NoError(t, err)
+	}
+	err = ts.PurgeTrashedMemos(ctx)
+	require.NoError(t, err)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID, IncludeTrashed: true})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoList))
+	resources, err = ts.ListResources(ctx, &store.FindResource{MemoID: &memo.ID, IncludeTrashed: true})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resources))
+	ts.Close()
+}
@@ -0,0 +1,85 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestRecurringMemoStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	weekly, err := ts.CreateRecurringMemo(ctx, &store.RecurringMemo{
+		CreatorID:      user.ID,
+		State:          store.RecurringMemoStateActive,
+		CronExpression: "0 17 * * 5",
+		Timezone:       "Europe/Berlin",
+		Payload:        &storepb.RecurringMemoPayload{Content: "## Retro"},
+	})
+	require.NoError(t, err)
+	monthly, err := ts.CreateRecurringMemo(ctx, &store.RecurringMemo{
+		CreatorID:      user.ID,
+		State:          store.RecurringMemoStateActive,
+		CronExpression: "0 9 1 * *",
+		Timezone:       "UTC",
+		Payload:        &storepb.RecurringMemoPayload{TemplateId: 1, Visibility: store.Protected.String()},
+	})
+	require.NoError(t, err)
+
+	recurringMemos, err := ts.ListRecurringMemos(ctx, &store.FindRecurringMemo{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(recurringMemos))
+	require.Equal(t, "## Retro", recurringMemos[0].Payload.Content)
+	require.Equal(t, int32(1), recurringMemos[1].Payload.TemplateId)
+
+	state, lastRunTs := store.RecurringMemoStatePaused, int64(1717232400)
+	updatedRecurringMemo, err := ts.UpdateRecurringMemo(ctx, &store.UpdateRecurringMemo{
+		ID:        weekly.ID,
+		State:     &state,
+		LastRunTs: &lastRunTs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, state, updatedRecurringMemo.State)
+	require.Equal(t, lastRunTs, updatedRecurringMemo.LastRunTs)
+	require.Equal(t, weekly.CronExpression, updatedRecurringMemo.CronExpression)
+
+	// The run is only recorded if the recurring memo has not run since.
+	staleLastRunTs, nextLastRunTs := weekly.LastRunTs, lastRunTs+3600
+	_, err = ts.UpdateRecurringMemo(ctx, &store.UpdateRecurringMemo{
+		ID:                weekly.ID,
+		LastRunTs:         &nextLastRunTs,
+		ExpectedLastRunTs: &staleLastRunTs,
+	})
+	require.ErrorIs(t, err, store.ErrRecurringMemoChanged)
+	updatedRecurringMemo, err = ts.UpdateRecurringMemo(ctx, &store.UpdateRecurringMemo{
+		ID:                weekly.ID,
+		LastRunTs:         &nextLastRunTs,
+		ExpectedLastRunTs: &lastRunTs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, nextLastRunTs, updatedRecurringMemo.LastRunTs)
+
+	activeState := store.RecurringMemoStateActive
+	recurringMemos, err = ts.ListRecurringMemos(ctx, &store.FindRecurringMemo{
+		State: &activeState,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(recurringMemos))
+	require.Equal(t, monthly.ID, recurringMemos[0].ID)
+
+	err = ts.DeleteRecurringMemo(ctx, &store.DeleteRecurringMemo{ID: monthly.ID})
+	require.NoError(t, err)
+	recurringMemo, err := ts.GetRecurringMemo(ctx, &store.FindRecurringMemo{ID: &monthly.ID})
+	require.NoError(t, err)
+	require.Nil(t, recurringMemo)
+	ts.Close()
+}
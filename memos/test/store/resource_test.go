@@ -2,11 +2,14 @@ package teststore
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/lithammer/shortuuid/v4"
 	"github.com/stretchr/testify/require"
 
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -61,3 +64,66 @@ func TestResourceStore(t *testing.T) {
 	require.NoError(t, err)
 	ts.Close()
 }
+
+func TestResourceCursorStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	resourceIDs := []int32{}
+	for i := 0; i < 3; i++ {
+		resource, err := ts.CreateResource(ctx, &store.Resource{
+			UID:       shortuuid.New(),
+			CreatorID: 101,
+			Filename:  "test.txt",
+			Blob:      []byte("test"),
+			Type:      "text/plain",
+			Size:      4,
+		})
+		require.NoError(t, err)
+		resourceIDs = append(resourceIDs, resource.ID)
+	}
+
+	limit := 2
+	resources, err := ts.ListResources(ctx, &store.FindResource{
+		Limit: &limit,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resources))
+	lastResource := resources[1]
+	resources, err = ts.ListResources(ctx, &store.FindResource{
+		Limit: &limit,
+		Cursor: &store.ResourceCursor{
+			UpdatedTs: lastResource.UpdatedTs,
+			ID:        lastResource.ID,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resources))
+	require.Equal(t, resourceIDs[0], resources[0].ID)
+	ts.Close()
+}
+
+func TestDeleteLocalResource(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	defer ts.Close()
+	path := filepath.Join(t.TempDir(), "test.txt")
+	require.NoError(t, os.WriteFile(path, []byte("test"), 0600))
+	resource, err := ts.CreateResource(ctx, &store.Resource{
+		UID:         shortuuid.New(),
+		CreatorID:   101,
+		Filename:    "test.txt",
+		Type:        "text/plain",
+		Size:        4,
+		StorageType: storepb.ResourceStorageType_LOCAL,
+		Reference:   path,
+	})
+	require.NoError(t, err)
+
+	// The file is deleted along with the resource.
+	require.NoError(t, ts.DeleteResource(ctx, &store.DeleteResource{ID: resource.ID}))
+	_, err = os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+	resources, err := ts.ListResources(ctx, &store.FindResource{ID: &resource.ID, IncludeTrashed: true})
+	require.NoError(t, err)
+	require.Empty(t, resources)
+}
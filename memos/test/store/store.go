@@ -41,12 +41,21 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS memo_relation;
 		DROP TABLE IF EXISTS resource;
 		DROP TABLE IF EXISTS tag;
+		DROP TABLE IF EXISTS memo_task;
+		DROP TABLE IF EXISTS memo_reminder;
+		DROP TABLE IF EXISTS memo_share_link;
+		DROP TABLE IF EXISTS memo_share;
+		DROP TABLE IF EXISTS memo_template;
+		DROP TABLE IF EXISTS recurring_memo;
+		DROP TABLE IF EXISTS collection;
+		DROP TABLE IF EXISTS collection_memo;
 		DROP TABLE IF EXISTS activity;
 		DROP TABLE IF EXISTS storage;
 		DROP TABLE IF EXISTS idp;
 		DROP TABLE IF EXISTS inbox;
 		DROP TABLE IF EXISTS webhook;
-		DROP TABLE IF EXISTS reaction;`)
+		DROP TABLE IF EXISTS reaction;
+		DROP TABLE IF EXISTS memo_revision;`)
 		if err != nil {
 			fmt.Printf("failed to reset testing db, error: %+v\n", err)
 			panic(err)
@@ -62,12 +71,21 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS memo_relation CASCADE;
 		DROP TABLE IF EXISTS resource CASCADE;
 		DROP TABLE IF EXISTS tag CASCADE;
+		DROP TABLE IF EXISTS memo_task CASCADE;
+		DROP TABLE IF EXISTS memo_reminder CASCADE;
+		DROP TABLE IF EXISTS memo_share_link CASCADE;
+		DROP TABLE IF EXISTS memo_share CASCADE;
+		DROP TABLE IF EXISTS memo_template CASCADE;
+		DROP TABLE IF EXISTS recurring_memo CASCADE;
+		DROP TABLE IF EXISTS collection CASCADE;
+		DROP TABLE IF EXISTS collection_memo CASCADE;
 		DROP TABLE IF EXISTS activity CASCADE;
 		DROP TABLE IF EXISTS storage CASCADE;
 		DROP TABLE IF EXISTS idp CASCADE;
 		DROP TABLE IF EXISTS inbox CASCADE;
 		DROP TABLE IF EXISTS webhook CASCADE;
-		DROP TABLE IF EXISTS reaction CASCADE;`)
+		DROP TABLE IF EXISTS reaction CASCADE;
+		DROP TABLE IF EXISTS memo_revision CASCADE;`)
 		if err != nil {
 			fmt.Printf("failed to reset testing db, error: %+v\n", err)
 			panic(err)
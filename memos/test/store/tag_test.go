@@ -0,0 +1,76 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestTagStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	tag, err := ts.CreateTag(ctx, &store.Tag{
+		CreatorID:   user.ID,
+		Name:        "project/alpha",
+		Color:       "#ff0000",
+		Description: "the alpha project",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "project/alpha", tag.Name)
+	require.Equal(t, "#ff0000", tag.Color)
+	require.False(t, tag.Pinned)
+	_, err = ts.CreateTag(ctx, &store.Tag{
+		CreatorID: user.ID,
+		Name:      "idea",
+		Pinned:    true,
+	})
+	require.NoError(t, err)
+	// Tags names are unique per user.
+	_, err = ts.CreateTag(ctx, &store.Tag{
+		CreatorID: user.ID,
+		Name:      "idea",
+	})
+	require.Error(t, err)
+
+	tags, err := ts.ListTags(ctx, &store.FindTag{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tags))
+	// Pinned tags are listed first.
+	require.Equal(t, "idea", tags[0].Name)
+	require.Equal(t, tag, tags[1])
+
+	tags, err = ts.ListTags(ctx, &store.FindTag{
+		NameList: []string{"project/alpha", "unknown"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tags))
+
+	pinned, emoji := true, "🚀"
+	updatedTag, err := ts.UpdateTag(ctx, &store.UpdateTag{
+		ID:     tag.ID,
+		Pinned: &pinned,
+		Emoji:  &emoji,
+	})
+	require.NoError(t, err)
+	require.True(t, updatedTag.Pinned)
+	require.Equal(t, emoji, updatedTag.Emoji)
+	require.Equal(t, tag.Color, updatedTag.Color)
+
+	err = ts.DeleteTag(ctx, &store.DeleteTag{
+		ID: tag.ID,
+	})
+	require.NoError(t, err)
+	tag, err = ts.GetTag(ctx, &store.FindTag{
+		ID: &tag.ID,
+	})
+	require.NoError(t, err)
+	require.Nil(t, tag)
+	ts.Close()
+}
@@ -0,0 +1,150 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lithammer/shortuuid/v4"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+var errInjected = errors.New("injected failure")
+
+func TestWithTxCommit(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	err = ts.WithTx(ctx, func(txStore *store.Store) error {
+		memo, err := txStore.CreateMemo(ctx, &store.Memo{
+			UID:        "test-memo",
+			CreatorID:  user.ID,
+			Content:    "test_content",
+			Visibility: store.Public,
+		})
+		if err != nil {
+			return err
+		}
+		content := "test_content_2"
+		return txStore.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:      memo.ID,
+			Content: &content,
+		})
+	})
+	require.NoError(t, err)
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoList))
+	require.Equal(t, "test_content_2", memoList[0].Content)
+	ts.Close()
+}
+
+func TestWithTxRollback(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	relatedMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-related-memo",
+		CreatorID:  user.ID,
+		Content:    "test_related_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        memo.ID,
+		RelatedMemoID: relatedMemo.ID,
+		Type:          store.MemoRelationReference,
+	})
+	require.NoError(t, err)
+
+	// Fail after replacing the relations and updating the content.
+	err = ts.WithTx(ctx, func(txStore *store.Store) error {
+		if err := txStore.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &memo.ID}); err != nil {
+			return err
+		}
+		content := "test_content_2"
+		if err := txStore.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:      memo.ID,
+			Content: &content,
+		}); err != nil {
+			return err
+		}
+		if _, err := txStore.CreateMemo(ctx, &store.Memo{
+			UID:        "test-new-memo",
+			CreatorID:  user.ID,
+			Content:    "test_new_content",
+			Visibility: store.Public,
+		}); err != nil {
+			return err
+		}
+		return errInjected
+	})
+	require.ErrorIs(t, err, errInjected)
+
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoList))
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, "test_content", memo.Content)
+	memoRelations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoRelations))
+	memoRevisions, err := ts.ListMemoRevisions(ctx, &store.FindMemoRevision{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoRevisions))
+	ts.Close()
+}
+
+func TestTrashMemoRollback(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "test-memo",
+		CreatorID:  user.ID,
+		Content:    "test_content",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateResource(ctx, &store.Resource{
+		UID:       shortuuid.New(),
+		CreatorID: user.ID,
+		Filename:  "test.txt",
+		Blob:      []byte("test"),
+		Type:      "text/plain",
+		Size:      4,
+		MemoID:    &memo.ID,
+	})
+	require.NoError(t, err)
+
+	// Nested transactions join the outer one, so the failure rolls back the trashed memo and resource.
+	err = ts.WithTx(ctx, func(txStore *store.Store) error {
+		if err := txStore.TrashMemo(ctx, memo.ID); err != nil {
+			return err
+		}
+		return errInjected
+	})
+	require.ErrorIs(t, err, errInjected)
+
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.NotNil(t, memo)
+	require.Zero(t, memo.DeletedTs)
+	resources, err := ts.ListResources(ctx, &store.FindResource{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resources))
+	ts.Close()
+}